@@ -12,13 +12,21 @@ import (
 
 type cmdCompileArgs struct {
 	File string `arg:"positional,required" help:"Path to the .vdl file to compile"`
+
+	DiagnosticsFormat string `arg:"--diagnostics-format" default:"text" help:"Output format for errors: text or json"`
 }
 
 func cmdCompile(args *cmdCompileArgs) {
+	validateDiagnosticsFormat(args.DiagnosticsFormat)
+
 	fs := vfs.New()
 	program, diagnostics := analysis.Analyze(fs, args.File)
 
 	if len(diagnostics) > 0 {
+		if args.DiagnosticsFormat == diagnosticsFormatJSON {
+			printJSONDiagnostics(toJSONDiagnostics(diagnostics))
+			os.Exit(1)
+		}
 		for _, d := range diagnostics {
 			printVDLError(d.Error())
 		}
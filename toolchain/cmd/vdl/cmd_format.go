@@ -1,17 +1,21 @@
 package main
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/varavelio/vdl/toolchain/internal/formatter"
 )
 
 type cmdFormatArgs struct {
 	Patterns []string `arg:"positional" help:"File patterns to format, supports recursive globs (default: ./**/*.vdl)"`
 	Verbose  bool     `arg:"--verbose"  help:"Print each file as it is formatted"`
+	Check    bool     `arg:"--check"    help:"Print a unified diff for unformatted files and exit non-zero, without writing (useful for CI)"`
 }
 
 func cmdFmt(args *cmdFormatArgs) {
@@ -64,6 +68,8 @@ func cmdFmt(args *cmdFormatArgs) {
 	}
 
 	formattedCount := 0
+	checkedCount := 0
+	unformattedCount := 0
 	for _, match := range dedupedMatches {
 		if !strings.HasSuffix(match, ".vdl") {
 			continue
@@ -79,6 +85,19 @@ func cmdFmt(args *cmdFormatArgs) {
 			printFatal("VDL failed to format '%s': %v", match, err)
 		}
 
+		if args.Check {
+			checkedCount++
+			if formatted == string(fileBytes) {
+				continue
+			}
+			unformattedCount++
+			fmt.Print(formatDiff(match, string(fileBytes), formatted))
+			if args.Verbose {
+				printWarn("VDL found unformatted file %s", match)
+			}
+			continue
+		}
+
 		if err := os.WriteFile(match, []byte(formatted), 0o644); err != nil {
 			printFatal("VDL failed to write file '%s': %v", match, err)
 		}
@@ -90,6 +109,29 @@ func cmdFmt(args *cmdFormatArgs) {
 		formattedCount++
 	}
 
+	if args.Check {
+		checkedText := "files"
+		if checkedCount == 1 {
+			checkedText = "file"
+		}
+		if unformattedCount > 0 {
+			printFatal(
+				"VDL found %d of %d %s not formatted in %s (check)",
+				unformattedCount,
+				checkedCount,
+				checkedText,
+				time.Since(startTime),
+			)
+		}
+		printSuccess(
+			"VDL checked %d %s in %s, all formatted (check)",
+			checkedCount,
+			checkedText,
+			time.Since(startTime),
+		)
+		return
+	}
+
 	filesText := "files"
 	if formattedCount == 1 {
 		filesText = "file"
@@ -97,3 +139,34 @@ func cmdFmt(args *cmdFormatArgs) {
 
 	printSuccess("VDL formatted %d %s in %s", formattedCount, filesText, time.Since(startTime))
 }
+
+// formatDiff returns a unified diff from the original content of path to its
+// formatted content, in the same layout as `diff -u` and `gofmt -d`.
+func formatDiff(path, original, formatted string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitDiffLines(original),
+		B:        splitDiffLines(formatted),
+		FromFile: "a/" + filepath.ToSlash(path),
+		ToFile:   "b/" + filepath.ToSlash(path),
+		Context:  3,
+	})
+	if err != nil {
+		printFatal("VDL failed to diff '%s': %v", path, err)
+	}
+	return diff
+}
+
+// splitDiffLines splits content into lines that keep their trailing newline,
+// without the empty element difflib.SplitLines adds after a final newline.
+// A last line without a newline gets the "\ No newline at end of file" marker
+// used by diff -u, so the hunk stays well formed.
+func splitDiffLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if last := len(lines) - 1; last >= 0 && !strings.HasSuffix(lines[last], "\n") {
+		lines[last] += "\n\\ No newline at end of file\n"
+	}
+	return lines
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatDiff(t *testing.T) {
+	original := "type  A {\n  b   string\n}\n"
+	formatted := "type A {\n  b string\n}\n"
+
+	require.Equal(t, `--- a/schema.vdl
++++ b/schema.vdl
+@@ -1,3 +1,3 @@
+-type  A {
+-  b   string
++type A {
++  b string
+ }
+`, formatDiff("schema.vdl", original, formatted))
+
+	require.Empty(t, formatDiff("schema.vdl", formatted, formatted))
+
+	require.Equal(t, `--- a/schema.vdl
++++ b/schema.vdl
+@@ -1 +1 @@
+-type  A string
+\ No newline at end of file
++type A string
+`, formatDiff("schema.vdl", "type  A string", "type A string\n"))
+}
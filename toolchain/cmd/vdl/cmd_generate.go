@@ -10,22 +10,40 @@ import (
 type cmdGenerateArgs struct {
 	Path  string `arg:"positional" help:"Directory or config file path (default: current directory, searching for vdl.config.vdl)"`
 	Check bool   `arg:"--check"    help:"Validate pipeline without writing output files (useful for lint/CI)"`
+	Watch bool   `arg:"--watch"    help:"Keep running and regenerate whenever the config, schemas, or local plugins change"`
+
+	DiagnosticsFormat string `arg:"--diagnostics-format" default:"text" help:"Output format for errors: text or json"`
 }
 
 func cmdGenerate(args *cmdGenerateArgs) {
+	validateDiagnosticsFormat(args.DiagnosticsFormat)
+
+	if args.Watch {
+		cmdGenerateWatch(args)
+		return
+	}
+
 	startTime := time.Now()
 	fileCount, err := codegen.Run(args.Path, args.Check)
 	if err != nil {
-		printVDLError(err.Error())
+		if args.DiagnosticsFormat == diagnosticsFormatJSON {
+			printJSONDiagnostics(errorToJSONDiagnostics(err))
+		} else {
+			printVDLError(err.Error())
+		}
 		os.Exit(1)
 	}
 
+	printGenerateSummary(args.Check, fileCount, startTime)
+}
+
+func printGenerateSummary(check bool, fileCount int, startTime time.Time) {
 	filesText := "files"
 	if fileCount == 1 {
 		filesText = "file"
 	}
 
-	if args.Check {
+	if check {
 		printSuccess(
 			"VDL would generate %d %s in %s (check)",
 			fileCount,
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/varavelio/vdl/toolchain/internal/codegen"
+)
+
+const (
+	// watchPollInterval is how often watched files are checked for changes.
+	watchPollInterval = 300 * time.Millisecond
+
+	// watchDebounce is how long files must stay unchanged before regenerating,
+	// so that editors saving several files at once trigger a single run.
+	watchDebounce = 200 * time.Millisecond
+)
+
+// fileStamp is the part of a file's metadata used to detect changes.
+type fileStamp struct {
+	exists  bool
+	size    int64
+	modTime time.Time
+}
+
+// watchSnapshot maps absolute file paths to their last observed stamp.
+type watchSnapshot map[string]fileStamp
+
+func takeWatchSnapshot(paths []string) watchSnapshot {
+	snapshot := make(watchSnapshot, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			snapshot[path] = fileStamp{}
+			continue
+		}
+		snapshot[path] = fileStamp{exists: true, size: info.Size(), modTime: info.ModTime()}
+	}
+	return snapshot
+}
+
+// changedFiles returns the sorted paths whose stamp differs between s and next,
+// including paths present in only one of them.
+func (s watchSnapshot) changedFiles(next watchSnapshot) []string {
+	var changed []string
+	for path, stamp := range next {
+		if previous, ok := s[path]; !ok || previous != stamp {
+			changed = append(changed, path)
+		}
+	}
+	for path := range s {
+		if _, ok := next[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// cmdGenerateWatch runs generation once and then again every time the config,
+// a schema (including its includes), or a local plugin changes. Errors are
+// printed and watching continues; only an interrupt stops the loop.
+func cmdGenerateWatch(args *cmdGenerateArgs) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	files := runWatchedGeneration(args, nil)
+	snapshot := takeWatchSnapshot(files)
+	printSuccess("VDL watching %d files for changes (press Ctrl+C to stop)", len(files))
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current := takeWatchSnapshot(files)
+		changed := snapshot.changedFiles(current)
+		if len(changed) == 0 {
+			continue
+		}
+
+		// Wait for the files to settle before regenerating.
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchDebounce):
+			}
+			settled := takeWatchSnapshot(files)
+			more := current.changedFiles(settled)
+			if len(more) == 0 {
+				break
+			}
+			changed = mergeSortedPaths(changed, more)
+			current = settled
+		}
+
+		printWarn("VDL detected changes in %s", describeChangedFiles(changed))
+		files = runWatchedGeneration(args, files)
+		snapshot = takeWatchSnapshot(files)
+	}
+}
+
+// runWatchedGeneration runs one generation pass, reports the outcome without
+// exiting, and returns the files to watch next. When the watch list cannot be
+// computed, the previous list is kept so a broken config can still be fixed.
+func runWatchedGeneration(args *cmdGenerateArgs, previous []string) []string {
+	startTime := time.Now()
+	fileCount, err := codegen.Run(args.Path, args.Check)
+	if err != nil {
+		if args.DiagnosticsFormat == diagnosticsFormatJSON {
+			printJSONDiagnostics(errorToJSONDiagnostics(err))
+		} else {
+			printVDLError(err.Error())
+		}
+	} else {
+		printGenerateSummary(args.Check, fileCount, startTime)
+	}
+
+	files, err := codegen.WatchedFiles(args.Path)
+	if len(files) > 0 {
+		return files
+	}
+	if err != nil && len(previous) == 0 {
+		printFatal("VDL error: cannot watch %q: %v", args.Path, err)
+	}
+	return previous
+}
+
+func mergeSortedPaths(a, b []string) []string {
+	merged := append(slices.Clone(a), b...)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
+// describeChangedFiles lists changed paths relative to the working directory
+// when possible.
+func describeChangedFiles(paths []string) string {
+	wd, _ := os.Getwd()
+	names := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if rel, err := filepath.Rel(wd, path); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+		names = append(names, path)
+	}
+	return strings.Join(names, ", ")
+}
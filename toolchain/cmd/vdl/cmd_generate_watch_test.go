@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchSnapshotChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.vdl")
+	common := filepath.Join(dir, "common.vdl")
+	missing := filepath.Join(dir, "missing.vdl")
+	require.NoError(t, os.WriteFile(schema, []byte("type A string\n"), 0o644))
+	require.NoError(t, os.WriteFile(common, []byte("type B string\n"), 0o644))
+
+	paths := []string{schema, common, missing}
+	before := takeWatchSnapshot(paths)
+	require.Empty(t, before.changedFiles(takeWatchSnapshot(paths)))
+
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(common, later, later))
+	require.NoError(t, os.WriteFile(missing, []byte("type C string\n"), 0o644))
+
+	after := takeWatchSnapshot(paths)
+	require.Equal(t, []string{common, missing}, before.changedFiles(after))
+
+	require.NoError(t, os.Remove(schema))
+	require.Equal(t, []string{schema}, after.changedFiles(takeWatchSnapshot(paths)))
+
+	require.Equal(t, []string{schema}, after.changedFiles(takeWatchSnapshot([]string{common, missing})))
+}
+
+func TestMergeSortedPaths(t *testing.T) {
+	require.Equal(t, []string{"/a", "/b", "/c"}, mergeSortedPaths([]string{"/a", "/c"}, []string{"/b", "/c"}))
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/ir"
+	"github.com/varavelio/vdl/toolchain/internal/core/ir/irtypes"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+	"github.com/varavelio/vdl/toolchain/internal/formatter"
+)
+
+type cmdTranspileArgs struct {
+	File string `arg:"positional,required" help:"Path to a .vdl schema or a .json IR file"`
+
+	DiagnosticsFormat string `arg:"--diagnostics-format" default:"text" help:"Output format for errors: text or json"`
+}
+
+func cmdTranspile(args *cmdTranspileArgs) {
+	if strings.EqualFold(filepath.Ext(args.File), ".json") {
+		validateDiagnosticsFormat(args.DiagnosticsFormat)
+
+		source, err := transpileIRToVDL(args.File)
+		if err != nil {
+			if args.DiagnosticsFormat == diagnosticsFormatJSON {
+				printJSONDiagnostics(errorToJSONDiagnostics(err))
+				os.Exit(1)
+			}
+			printFatal("VDL error: %v", err)
+		}
+
+		fmt.Print(source)
+		return
+	}
+
+	cmdCompile(&cmdCompileArgs{File: args.File, DiagnosticsFormat: args.DiagnosticsFormat})
+}
+
+// transpileIRToVDL decodes an IR JSON file and renders it as formatted VDL.
+// Decoding enforces the IR schema (required fields and enum values), and the
+// rendered source is compiled once more and must yield the decoded IR again,
+// positions and entry point aside, so that no value changes silently.
+func transpileIRToVDL(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read IR file: %w", err)
+	}
+
+	var schema irtypes.IrSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return "", fmt.Errorf("invalid IR in %s: %w", path, err)
+	}
+
+	if problems := undefinedIRReferences(&schema); len(problems) > 0 {
+		return "", fmt.Errorf(
+			"IR in %s does not describe a valid schema:\n%s",
+			path, strings.Join(problems, "\n"),
+		)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve IR file path: %w", err)
+	}
+	renderedPath := strings.TrimSuffix(absPath, filepath.Ext(absPath)) + ".vdl"
+
+	source, err := formatter.Format(renderedPath, ir.ToVDL(&schema))
+	if err != nil {
+		return "", fmt.Errorf("failed to format rendered schema: %w", err)
+	}
+
+	fs := vfs.New()
+	fs.WriteFileCache(renderedPath, []byte(source))
+	program, diagnostics := analysis.Analyze(fs, renderedPath)
+	if len(diagnostics) > 0 {
+		messages := make([]string, 0, len(diagnostics))
+		for _, diag := range diagnostics {
+			messages = append(messages, diag.Message)
+		}
+		return "", fmt.Errorf(
+			"IR in %s does not describe a valid schema:\n%s",
+			path, strings.Join(messages, "\n"),
+		)
+	}
+
+	same, err := sameIRIgnoringPositions(&schema, ir.FromProgram(program))
+	if err != nil {
+		return "", fmt.Errorf("failed to compare rendered schema: %w", err)
+	}
+	if !same {
+		return "", fmt.Errorf("IR in %s cannot be rendered as VDL that compiles back to the same IR", path)
+	}
+
+	return source, nil
+}
+
+// undefinedIRReferences reports named type and enum references that point at
+// declarations missing from the schema, prefixed with where they occur.
+func undefinedIRReferences(schema *irtypes.IrSchema) []string {
+	types := make(map[string]bool, len(schema.Types))
+	for _, typ := range schema.Types {
+		types[typ.Name] = true
+	}
+	enums := make(map[string]bool, len(schema.Enums))
+	for _, enum := range schema.Enums {
+		enums[enum.Name] = true
+	}
+
+	var problems []string
+	ir.Walk(schema, ir.Visitor{
+		TypeRef: func(path []string, ref *irtypes.TypeRef) bool {
+			switch {
+			case ref.Kind == irtypes.TypeKindType && !types[ref.GetTypeName()]:
+				problems = append(problems, fmt.Sprintf(
+					"%s: undefined type %q", strings.Join(path, "."), ref.GetTypeName(),
+				))
+			case ref.Kind == irtypes.TypeKindEnum && !enums[ref.GetEnumName()]:
+				problems = append(problems, fmt.Sprintf(
+					"%s: undefined enum %q", strings.Join(path, "."), ref.GetEnumName(),
+				))
+			}
+			return true
+		},
+	})
+	return problems
+}
+
+// sameIRIgnoringPositions reports whether two IR schemas are equal once
+// positions and the entry point, which depend on where the source lives, are
+// left out.
+func sameIRIgnoringPositions(a, b *irtypes.IrSchema) (bool, error) {
+	normalize := func(schema *irtypes.IrSchema) (string, error) {
+		data, err := json.Marshal(schema)
+		if err != nil {
+			return "", err
+		}
+		var value map[string]any
+		if err := json.Unmarshal(data, &value); err != nil {
+			return "", err
+		}
+		delete(value, "entryPoint")
+		data, err = json.Marshal(stripIRPositions(value))
+		return string(data), err
+	}
+
+	left, err := normalize(a)
+	if err != nil {
+		return false, err
+	}
+	right, err := normalize(b)
+	if err != nil {
+		return false, err
+	}
+	return left == right, nil
+}
+
+// stripIRPositions removes every "position" key from a decoded IR document.
+func stripIRPositions(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		delete(v, "position")
+		for key, child := range v {
+			v[key] = stripIRPositions(child)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = stripIRPositions(child)
+		}
+	}
+	return value
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/ir"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+)
+
+func TestTranspileIRToVDL(t *testing.T) {
+	t.Run("renders formatted VDL from compiled IR", func(t *testing.T) {
+		dir := t.TempDir()
+		schemaPath := filepath.Join(dir, "schema.vdl")
+		schema := "enum Role {\n  Admin\n  Member = \"member\"\n}\n\n" +
+			"@entity\ntype User {\n  name string\n  role? Role\n}\n"
+		require.NoError(t, os.WriteFile(schemaPath, []byte(schema), 0o644))
+
+		program, diagnostics := analysis.Analyze(vfs.New(), schemaPath)
+		require.Empty(t, diagnostics)
+		data, err := json.Marshal(ir.FromProgram(program))
+		require.NoError(t, err)
+
+		irPath := filepath.Join(dir, "schema.json")
+		require.NoError(t, os.WriteFile(irPath, data, 0o644))
+
+		source, err := transpileIRToVDL(irPath)
+		require.NoError(t, err)
+		require.Equal(t, schema, source)
+	})
+
+	t.Run("round-trips escaped enum values", func(t *testing.T) {
+		dir := t.TempDir()
+		schemaPath := filepath.Join(dir, "schema.vdl")
+		schema := "enum Color {\n  Red = \"r\\\"ed\"\n  Path = \"a\\\\b\"\n}\n"
+		require.NoError(t, os.WriteFile(schemaPath, []byte(schema), 0o644))
+
+		program, diagnostics := analysis.Analyze(vfs.New(), schemaPath)
+		require.Empty(t, diagnostics)
+		data, err := json.Marshal(ir.FromProgram(program))
+		require.NoError(t, err)
+
+		irPath := filepath.Join(dir, "schema.json")
+		require.NoError(t, os.WriteFile(irPath, data, 0o644))
+
+		source, err := transpileIRToVDL(irPath)
+		require.NoError(t, err)
+		require.Equal(t, schema, source)
+	})
+
+	t.Run("rejects IR that VDL cannot represent", func(t *testing.T) {
+		// A primitive type reference that also names a type has no VDL
+		// spelling, so the rendered source compiles to different IR.
+		irPath := filepath.Join(t.TempDir(), "schema.json")
+		payload := `{
+			"entryPoint": "/schema.vdl",
+			"constants": [],
+			"enums": [],
+			"docs": [],
+			"types": [{
+				"position": {"file": "", "line": 0, "column": 0},
+				"name": "Name",
+				"annotations": [],
+				"typeRef": {"kind": "primitive", "primitiveName": "string", "typeName": "Ghost"}
+			}]
+		}`
+		require.NoError(t, os.WriteFile(irPath, []byte(payload), 0o644))
+
+		_, err := transpileIRToVDL(irPath)
+		require.ErrorContains(t, err, "cannot be rendered as VDL that compiles back to the same IR")
+	})
+
+	t.Run("reports the path of undefined enum references", func(t *testing.T) {
+		irPath := filepath.Join(t.TempDir(), "schema.json")
+		payload := `{
+			"entryPoint": "/schema.vdl",
+			"constants": [],
+			"enums": [],
+			"docs": [],
+			"types": [{
+				"position": {"file": "", "line": 0, "column": 0},
+				"name": "User",
+				"annotations": [],
+				"typeRef": {"kind": "object", "objectFields": [{
+					"position": {"file": "", "line": 0, "column": 0},
+					"name": "settings",
+					"optional": false,
+					"annotations": [],
+					"typeRef": {"kind": "object", "objectFields": [{
+						"position": {"file": "", "line": 0, "column": 0},
+						"name": "theme",
+						"optional": false,
+						"annotations": [],
+						"typeRef": {"kind": "enum", "enumName": "Theme", "enumType": "string"}
+					}]}
+				}]}
+			}]
+		}`
+		require.NoError(t, os.WriteFile(irPath, []byte(payload), 0o644))
+
+		_, err := transpileIRToVDL(irPath)
+		require.ErrorContains(t, err, `User.settings.theme: undefined enum "Theme"`)
+	})
+
+	t.Run("rejects IR missing required fields", func(t *testing.T) {
+		irPath := filepath.Join(t.TempDir(), "schema.json")
+		require.NoError(t, os.WriteFile(irPath, []byte(`{"types": []}`), 0o644))
+
+		_, err := transpileIRToVDL(irPath)
+		require.ErrorContains(t, err, "entryPoint is required")
+	})
+
+	t.Run("rejects IR that references undefined types", func(t *testing.T) {
+		irPath := filepath.Join(t.TempDir(), "schema.json")
+		payload := `{
+			"entryPoint": "/schema.vdl",
+			"constants": [],
+			"enums": [],
+			"docs": [],
+			"types": [{
+				"position": {"file": "", "line": 0, "column": 0},
+				"name": "User",
+				"annotations": [],
+				"typeRef": {"kind": "type", "typeName": "Missing"}
+			}]
+		}`
+		require.NoError(t, os.WriteFile(irPath, []byte(payload), 0o644))
+
+		_, err := transpileIRToVDL(irPath)
+		require.ErrorContains(t, err, "does not describe a valid schema")
+		require.ErrorContains(t, err, `User: undefined type "Missing"`)
+	})
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/varavelio/vdl/toolchain/internal/codegen"
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+)
+
+const (
+	diagnosticsFormatText = "text"
+	diagnosticsFormatJSON = "json"
+)
+
+// jsonDiagnostic is the machine-readable shape emitted by
+// --diagnostics-format=json. Positions are one-based, like the text output.
+type jsonDiagnostic struct {
+	File      string `json:"file,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Column    int    `json:"column,omitempty"`
+	EndLine   int    `json:"endLine,omitempty"`
+	EndColumn int    `json:"endColumn,omitempty"`
+	Severity  string `json:"severity"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message"`
+}
+
+// validateDiagnosticsFormat exits with an error when the requested format is
+// not supported.
+func validateDiagnosticsFormat(format string) {
+	switch format {
+	case diagnosticsFormatText, diagnosticsFormatJSON:
+		return
+	}
+	printFatal(
+		"VDL error: invalid --diagnostics-format %q (expected %q or %q)",
+		format,
+		diagnosticsFormatText,
+		diagnosticsFormatJSON,
+	)
+}
+
+// toJSONDiagnostics converts analysis diagnostics into their JSON shape.
+func toJSONDiagnostics(diagnostics []analysis.Diagnostic) []jsonDiagnostic {
+	result := make([]jsonDiagnostic, len(diagnostics))
+	for i, diag := range diagnostics {
+		result[i] = jsonDiagnostic{
+			File:      diag.File,
+			Line:      diag.Pos.Line,
+			Column:    diag.Pos.Column,
+			EndLine:   diag.EndPos.Line,
+			EndColumn: diag.EndPos.Column,
+			Severity:  "error", // All analysis diagnostics are treated as errors for now
+			Code:      diag.Code,
+			Message:   diag.Message,
+		}
+	}
+	return result
+}
+
+// errorToJSONDiagnostics extracts structured diagnostics from err, falling
+// back to a single position-less entry for errors that do not carry any.
+func errorToJSONDiagnostics(err error) []jsonDiagnostic {
+	var diagErr *codegen.DiagnosticsError
+	if errors.As(err, &diagErr) {
+		return toJSONDiagnostics(diagErr.Diagnostics)
+	}
+	return []jsonDiagnostic{{Severity: "error", Message: err.Error()}}
+}
+
+// printJSONDiagnostics writes diagnostics as an indented JSON array to stderr.
+func printJSONDiagnostics(diagnostics []jsonDiagnostic) {
+	data, err := json.MarshalIndent(diagnostics, "", "  ")
+	if err != nil {
+		printFatal("VDL error: failed to marshal diagnostics to JSON: %v", err)
+	}
+	fmt.Fprintln(os.Stderr, string(data))
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+)
+
+func TestToJSONDiagnostics(t *testing.T) {
+	t.Run("multiple schema errors produce a JSON array", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schema.vdl")
+		schema := "type User {\n  name Missing\n  role Unknown\n}\n"
+		require.NoError(t, os.WriteFile(path, []byte(schema), 0o644))
+
+		_, diagnostics := analysis.Analyze(vfs.New(), path)
+		require.Len(t, diagnostics, 2)
+
+		data, err := json.Marshal(toJSONDiagnostics(diagnostics))
+		require.NoError(t, err)
+
+		var decoded []map[string]any
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Len(t, decoded, 2)
+
+		for i, entry := range decoded {
+			require.Equal(t, path, entry["file"])
+			require.Equal(t, float64(i+2), entry["line"])
+			require.Equal(t, float64(3), entry["column"])
+			require.Equal(t, "error", entry["severity"])
+			require.Equal(t, "E201", entry["code"])
+			require.NotEmpty(t, entry["message"])
+		}
+	})
+
+	t.Run("plain errors become a single position-less entry", func(t *testing.T) {
+		diagnostics := errorToJSONDiagnostics(errors.New("boom"))
+		require.Equal(t, []jsonDiagnostic{{Severity: "error", Message: "boom"}}, diagnostics)
+	})
+}
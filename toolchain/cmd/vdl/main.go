@@ -10,12 +10,13 @@ import (
 )
 
 type allArgs struct {
-	Init     *cmdInitArgs     `arg:"subcommand:init"     help:"Initialize a new VDL project in the specified directory"`
-	Format   *cmdFormatArgs   `arg:"subcommand:format"   help:"Format VDL files matching the given glob patterns"`
-	Generate *cmdGenerateArgs `arg:"subcommand:generate" help:"Run code generation from a vdl.config.vdl project"`
-	Compile  *cmdCompileArgs  `arg:"subcommand:compile"  help:"Compile a VDL file and emit its IR as JSON"`
-	LSP      *cmdLSPArgs      `arg:"subcommand:lsp"      help:"Start the VDL Language Server"`
-	Version  *struct{}        `arg:"subcommand:version"  help:"Show VDL version information"`
+	Init      *cmdInitArgs      `arg:"subcommand:init"      help:"Initialize a new VDL project in the specified directory"`
+	Format    *cmdFormatArgs    `arg:"subcommand:format"    help:"Format VDL files matching the given glob patterns"`
+	Generate  *cmdGenerateArgs  `arg:"subcommand:generate"  help:"Run code generation from a vdl.config.vdl project"`
+	Compile   *cmdCompileArgs   `arg:"subcommand:compile"   help:"Compile a VDL file and emit its IR as JSON"`
+	Transpile *cmdTranspileArgs `arg:"subcommand:transpile" help:"Convert a VDL file to IR JSON, or IR JSON back to VDL"`
+	LSP       *cmdLSPArgs       `arg:"subcommand:lsp"       help:"Start the VDL Language Server"`
+	Version   *struct{}         `arg:"subcommand:version"   help:"Show VDL version information"`
 }
 
 func printVersion() {
@@ -79,6 +80,11 @@ func main() {
 		return
 	}
 
+	if args.Transpile != nil {
+		cmdTranspile(args.Transpile)
+		return
+	}
+
 	// If no subcommand was specified, show version by default
 	printVersion()
 }
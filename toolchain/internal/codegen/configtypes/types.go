@@ -15,6 +15,9 @@ type VdlConfig struct {
 	Version int64 `json:"version"`
 	// If VDL should clean the output directories before generating code. Default is true.
 	CleanOutDir *bool `json:"cleanOutDir,omitempty"`
+	// If VDL should write a `vdl.manifest.json` file next to this config listing every generated
+	// file, its hash, and the schema and plugin that produced it. Default is false.
+	Manifest *bool `json:"manifest,omitempty"`
 	// Optional list of remote repositories or servers that can be used to fetch plugins.
 	// Not required but useful if you want to use plugins from private repositories or
 	// servers that require authentication. Each plugin will be matched against the remotes
@@ -31,6 +34,7 @@ type VdlConfig struct {
 type preVdlConfig struct {
 	Version     *int64                `json:"version"`
 	CleanOutDir *bool                 `json:"cleanOutDir,omitempty"`
+	Manifest    *bool                 `json:"manifest,omitempty"`
 	Remotes     *[]preVdlConfigRemote `json:"remotes,omitempty"`
 	Plugins     *[]preVdlConfigPlugin `json:"plugins,omitempty"`
 	Hooks       *VdlConfigHooks       `json:"hooks,omitempty"`
@@ -83,6 +87,9 @@ func (p *preVdlConfig) transform() VdlConfig {
 	var transCleanOutDir *bool
 	transCleanOutDir = p.CleanOutDir
 
+	var transManifest *bool
+	transManifest = p.Manifest
+
 	var transRemotes *[]VdlConfigRemote
 	if p.Remotes != nil {
 		var valueRemotes []VdlConfigRemote
@@ -113,6 +120,7 @@ func (p *preVdlConfig) transform() VdlConfig {
 	return VdlConfig{
 		Version:     transVersion,
 		CleanOutDir: transCleanOutDir,
+		Manifest:    transManifest,
 		Remotes:     transRemotes,
 		Plugins:     transPlugins,
 		Hooks:       transHooks,
@@ -166,6 +174,23 @@ func (x *VdlConfig) GetCleanOutDirOr(defaultValue bool) bool {
 	return defaultValue
 }
 
+// GetManifest returns the Manifest field. It returns the zero value when the receiver or field is nil.
+func (x *VdlConfig) GetManifest() bool {
+	if x != nil && x.Manifest != nil {
+		return *x.Manifest
+	}
+	var zero bool
+	return zero
+}
+
+// GetManifestOr returns the Manifest field. It returns defaultValue when the receiver or field is nil.
+func (x *VdlConfig) GetManifestOr(defaultValue bool) bool {
+	if x != nil && x.Manifest != nil {
+		return *x.Manifest
+	}
+	return defaultValue
+}
+
 // GetRemotes returns the Remotes field. It returns the zero value when the receiver or field is nil.
 func (x *VdlConfig) GetRemotes() []VdlConfigRemote {
 	if x != nil && x.Remotes != nil {
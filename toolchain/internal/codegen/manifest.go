@@ -0,0 +1,142 @@
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/varavelio/vdl/toolchain/internal/codegen/manifesttypes"
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+	"github.com/varavelio/vdl/toolchain/internal/version"
+)
+
+const (
+	defaultManifestFileName = "vdl.manifest.json"
+	manifestFileVersion     = int64(1)
+)
+
+// buildManifest describes every planned write grouped by the plugin that
+// produced it, with paths relative to the config directory.
+func buildManifest(
+	config runtimeConfig,
+	results []executedPlugin,
+	plan outputPlan,
+) (manifesttypes.VdlManifestSchema, error) {
+	filesByPlugin := make(map[int][]manifesttypes.VdlManifestFile, len(results))
+	for _, write := range plan.Writes {
+		path, err := relativeManifestPath(config.Dir, write.AbsolutePath)
+		if err != nil {
+			return manifesttypes.VdlManifestSchema{}, err
+		}
+		filesByPlugin[write.PluginIndex] = append(
+			filesByPlugin[write.PluginIndex],
+			manifesttypes.VdlManifestFile{
+				Path: path,
+				Hash: sha256Digest([]byte(write.Content)),
+			},
+		)
+	}
+
+	plugins := make([]manifesttypes.VdlManifestPlugin, 0, len(results))
+	for _, result := range results {
+		schemaPath, err := relativeManifestPath(config.Dir, result.Plugin.SchemaPath)
+		if err != nil {
+			return manifesttypes.VdlManifestSchema{}, err
+		}
+		outDir, err := relativeManifestPath(config.Dir, result.Plugin.OutDir)
+		if err != nil {
+			return manifesttypes.VdlManifestSchema{}, err
+		}
+
+		files := filesByPlugin[result.Plugin.Index]
+		if files == nil {
+			files = []manifesttypes.VdlManifestFile{}
+		}
+		sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+		plugins = append(plugins, manifesttypes.VdlManifestPlugin{
+			Src:        result.Plugin.Source.Original,
+			SrcHash:    result.Plugin.Source.ContentHash,
+			Schema:     schemaPath,
+			SchemaHash: result.Plugin.SchemaHash,
+			OutDir:     outDir,
+			Files:      files,
+		})
+	}
+
+	return manifesttypes.VdlManifestSchema{
+		Version:    manifestFileVersion,
+		VdlVersion: version.Version,
+		Plugins:    plugins,
+	}, nil
+}
+
+// writeManifestFile persists the manifest as pretty-printed JSON.
+func writeManifestFile(path string, manifest manifesttypes.VdlManifestSchema) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest file %q: %w", path, err)
+	}
+	data = append(data, '\n')
+
+	if err := os.WriteFile(path, data, generatedFileMode); err != nil {
+		return fmt.Errorf("failed to write manifest file %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// removeManifestFile deletes a manifest left by an earlier run, so that turning
+// the manifest off does not leave a stale file listing old outputs.
+func removeManifestFile(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove manifest file %q: %w", path, err)
+	}
+	return nil
+}
+
+// relativeManifestPath returns path relative to baseDir using forward slashes
+// so manifests are stable across operating systems.
+func relativeManifestPath(baseDir, path string) (string, error) {
+	rel, err := filepath.Rel(baseDir, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve manifest path for %q: %w", path, err)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// hashProgramSources hashes the path (relative to baseDir) and content of every
+// VDL file loaded by the program, in path order.
+func hashProgramSources(fs *vfs.FileSystem, program *analysis.Program, baseDir string) (string, error) {
+	type source struct {
+		path    string
+		content []byte
+	}
+
+	sources := make([]source, 0, len(program.Files))
+	for absPath := range program.Files {
+		content, err := fs.ReadFile(absPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read schema file %q: %w", absPath, err)
+		}
+		path, err := relativeManifestPath(baseDir, absPath)
+		if err != nil {
+			return "", err
+		}
+		sources = append(sources, source{path: path, content: content})
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].path < sources[j].path })
+
+	hash := sha256.New()
+	for _, src := range sources {
+		fmt.Fprintf(hash, "%s\x00%d\x00", src.path, len(src.content))
+		hash.Write(src.content)
+	}
+	return "sha256-" + hex.EncodeToString(hash.Sum(nil)), nil
+}
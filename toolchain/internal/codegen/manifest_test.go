@@ -0,0 +1,168 @@
+package codegen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varavelio/vdl/toolchain/internal/codegen/manifesttypes"
+	"github.com/varavelio/vdl/toolchain/internal/version"
+)
+
+func TestRunWritesManifest(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "schema.vdl"), "include \"./common.vdl\"\n\ntype User {\n  id Id\n}\n")
+	writeTestFile(t, filepath.Join(dir, "common.vdl"), "type Id string\n")
+	writeTestFile(
+		t,
+		filepath.Join(dir, "plugin/index.js"),
+		`exports.generate = () => ({ files: [
+			{ path: "b.txt", content: "bee" },
+			{ path: "nested/a.txt", content: "ay" },
+		] })`,
+	)
+	writeTestFile(t, filepath.Join(dir, defaultConfigFileName), `
+		const config = {
+			version 1
+			manifest true
+			plugins [
+				{
+					src "./plugin/index.js"
+					schema "./schema.vdl"
+					outDir "./gen"
+					generateHeader false
+				}
+			]
+		}
+	`)
+
+	fileCount, err := Run(dir, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, fileCount)
+
+	manifest := readManifestFile(t, filepath.Join(dir, defaultManifestFileName))
+	require.Equal(t, manifestFileVersion, manifest.Version)
+	require.Equal(t, version.Version, manifest.VdlVersion)
+	require.Len(t, manifest.Plugins, 1)
+
+	plugin := manifest.Plugins[0]
+	require.Equal(t, "./plugin/index.js", plugin.Src)
+	require.Regexp(t, `^sha256-[0-9a-f]{64}$`, plugin.SrcHash)
+	require.Equal(t, "schema.vdl", plugin.Schema)
+	require.Regexp(t, `^sha256-[0-9a-f]{64}$`, plugin.SchemaHash)
+	require.Equal(t, "gen", plugin.OutDir)
+	require.Equal(t, []manifesttypes.VdlManifestFile{
+		{Path: "gen/b.txt", Hash: sha256Digest([]byte("bee"))},
+		{Path: "gen/nested/a.txt", Hash: sha256Digest([]byte("ay"))},
+	}, plugin.Files)
+
+	firstSchemaHash := plugin.SchemaHash
+
+	// Changing an included file and the plugin outputs must be reflected on the
+	// next run.
+	writeTestFile(t, filepath.Join(dir, "common.vdl"), "type Id int\n")
+	writeTestFile(
+		t,
+		filepath.Join(dir, "plugin/index.js"),
+		`exports.generate = () => ({ files: [{ path: "c.txt", content: "sea" }] })`,
+	)
+
+	_, err = Run(dir, false)
+	require.NoError(t, err)
+
+	manifest = readManifestFile(t, filepath.Join(dir, defaultManifestFileName))
+	require.Len(t, manifest.Plugins, 1)
+	require.NotEqual(t, firstSchemaHash, manifest.Plugins[0].SchemaHash)
+	require.Equal(t, []manifesttypes.VdlManifestFile{
+		{Path: "gen/c.txt", Hash: sha256Digest([]byte("sea"))},
+	}, manifest.Plugins[0].Files)
+}
+
+func TestRunSkipsManifest(t *testing.T) {
+	newProject := func(t *testing.T, manifest bool) string {
+		t.Helper()
+		dir := t.TempDir()
+		writeTestFile(t, filepath.Join(dir, "schema.vdl"), "type User {\n  name string\n}\n")
+		writeTestFile(
+			t,
+			filepath.Join(dir, "plugin/index.js"),
+			`exports.generate = () => ({ files: [{ path: "generated.txt", content: "hello" }] })`,
+		)
+		configManifest := "false"
+		if manifest {
+			configManifest = "true"
+		}
+		writeTestFile(t, filepath.Join(dir, defaultConfigFileName), `
+			const config = {
+				version 1
+				manifest `+configManifest+`
+				plugins [
+					{
+						src "./plugin/index.js"
+						schema "./schema.vdl"
+						outDir "./gen"
+					}
+				]
+			}
+		`)
+		return dir
+	}
+
+	t.Run("when disabled", func(t *testing.T) {
+		dir := newProject(t, false)
+		_, err := Run(dir, false)
+		require.NoError(t, err)
+		require.NoFileExists(t, filepath.Join(dir, defaultManifestFileName))
+	})
+
+	t.Run("removes a manifest from an earlier run once disabled", func(t *testing.T) {
+		dir := newProject(t, true)
+		_, err := Run(dir, false)
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(dir, defaultManifestFileName))
+
+		disabled := newProject(t, false)
+		config, err := os.ReadFile(filepath.Join(disabled, defaultConfigFileName))
+		require.NoError(t, err)
+		writeTestFile(t, filepath.Join(dir, defaultConfigFileName), string(config))
+
+		_, err = Run(dir, false)
+		require.NoError(t, err)
+		require.NoFileExists(t, filepath.Join(dir, defaultManifestFileName))
+	})
+
+	t.Run("in check mode", func(t *testing.T) {
+		dir := newProject(t, true)
+		_, err := Run(dir, true)
+		require.NoError(t, err)
+		require.NoFileExists(t, filepath.Join(dir, defaultManifestFileName))
+	})
+}
+
+func readManifestFile(t *testing.T, path string) manifesttypes.VdlManifestSchema {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var manifest manifesttypes.VdlManifestSchema
+	require.NoError(t, json.Unmarshal(data, &manifest))
+	return manifest
+}
+
+func TestBuildPluginInputHashesSchemaOnRequest(t *testing.T) {
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "schema.vdl")
+	writeTestFile(t, schemaPath, "type User {\n  name string\n}\n")
+	plugin := runtimePlugin{SchemaPath: schemaPath}
+
+	_, schemaHash, err := buildPluginInput(plugin, false)
+	require.NoError(t, err)
+	require.Empty(t, schemaHash)
+
+	_, schemaHash, err = buildPluginInput(plugin, true)
+	require.NoError(t, err)
+	require.Regexp(t, `^sha256-[0-9a-f]{64}$`, schemaHash)
+}
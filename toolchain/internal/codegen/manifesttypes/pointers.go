@@ -0,0 +1,27 @@
+// Code generated by VDL v0.0.0-dev (commit unknown) using varavelio/vdl-plugin-go@v0.1.0 (hash c4cd3d26)
+// Any changes will be overwritten the next time VDL is run. DO NOT EDIT.
+// Learn more: https://github.com/varavelio/vdl
+
+package manifesttypes
+
+// Ptr returns a pointer to the provided value.
+func Ptr[T any](value T) *T {
+	return &value
+}
+
+// Val dereferences a pointer or returns the zero value when it is nil.
+func Val[T any](pointer *T) T {
+	if pointer == nil {
+		var zero T
+		return zero
+	}
+	return *pointer
+}
+
+// Or dereferences a pointer or returns the provided default when it is nil.
+func Or[T any](pointer *T, defaultValue T) T {
+	if pointer == nil {
+		return defaultValue
+	}
+	return *pointer
+}
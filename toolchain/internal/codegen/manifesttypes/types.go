@@ -0,0 +1,491 @@
+// Code generated by VDL v0.0.0-dev (commit unknown) using varavelio/vdl-plugin-go@v0.1.0 (hash c4cd3d26)
+// Any changes will be overwritten the next time VDL is run. DO NOT EDIT.
+// Learn more: https://github.com/varavelio/vdl
+
+package manifesttypes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// A single generated file.
+type VdlManifestFile struct {
+	// The file path relative to the config file directory, using forward slashes.
+	Path string `json:"path"`
+	// The SHA-256 hash of the written file content in the format "sha256-<hex-string>".
+	Hash string `json:"hash"`
+}
+
+// preVdlManifestFile mirrors VdlManifestFile during strict JSON decoding.
+type preVdlManifestFile struct {
+	Path *string `json:"path"`
+	Hash *string `json:"hash"`
+}
+
+// validate reports whether preVdlManifestFile satisfies strict JSON requirements.
+func (p *preVdlManifestFile) validate(parentPath string) error {
+	vdlPathPath := "path"
+	if parentPath != "" {
+		vdlPathPath = parentPath + ".path"
+	}
+	if p.Path == nil {
+		return fmt.Errorf("field %s is required", vdlPathPath)
+	}
+
+	vdlPathHash := "hash"
+	if parentPath != "" {
+		vdlPathHash = parentPath + ".hash"
+	}
+	if p.Hash == nil {
+		return fmt.Errorf("field %s is required", vdlPathHash)
+	}
+	return nil
+}
+
+// transform converts preVdlManifestFile to VdlManifestFile.
+func (p *preVdlManifestFile) transform() VdlManifestFile {
+	var transPath string
+	transPath = *p.Path
+
+	var transHash string
+	transHash = *p.Hash
+
+	return VdlManifestFile{
+		Path: transPath,
+		Hash: transHash,
+	}
+}
+
+// UnmarshalJSON decodes VdlManifestFile while requiring every non-optional JSON field.
+func (x *VdlManifestFile) UnmarshalJSON(data []byte) error {
+	var pre preVdlManifestFile
+	if err := json.Unmarshal(data, &pre); err != nil {
+		return err
+	}
+	if err := pre.validate(""); err != nil {
+		return err
+	}
+	*x = pre.transform()
+	return nil
+}
+
+// GetPath returns the Path field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	var zero string
+	return zero
+}
+
+// GetPathOr returns the Path field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestFile) GetPathOr(defaultValue string) string {
+	if x != nil {
+		return x.Path
+	}
+	return defaultValue
+}
+
+// GetHash returns the Hash field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestFile) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	var zero string
+	return zero
+}
+
+// GetHashOr returns the Hash field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestFile) GetHashOr(defaultValue string) string {
+	if x != nil {
+		return x.Hash
+	}
+	return defaultValue
+}
+
+// Generation details for a single configured plugin.
+type VdlManifestPlugin struct {
+	// The plugin source exactly as written in the config `src` field.
+	Src string `json:"src"`
+	// The SHA-256 hash of the executed plugin script in the format "sha256-<hex-string>".
+	SrcHash string `json:"srcHash"`
+	// The input schema path, relative to the config file directory.
+	Schema string `json:"schema"`
+	// The SHA-256 hash of the input schema in the format "sha256-<hex-string>".
+	//
+	// It covers the path (relative to the schema file directory) and the content
+	// of every VDL file loaded for the schema, including transitive includes.
+	SchemaHash string `json:"schemaHash"`
+	// The output directory, relative to the config file directory.
+	OutDir string `json:"outDir"`
+	// Files generated by this plugin, sorted by path.
+	Files []VdlManifestFile `json:"files"`
+}
+
+// preVdlManifestPlugin mirrors VdlManifestPlugin during strict JSON decoding.
+type preVdlManifestPlugin struct {
+	Src        *string               `json:"src"`
+	SrcHash    *string               `json:"srcHash"`
+	Schema     *string               `json:"schema"`
+	SchemaHash *string               `json:"schemaHash"`
+	OutDir     *string               `json:"outDir"`
+	Files      *[]preVdlManifestFile `json:"files"`
+}
+
+// validate reports whether preVdlManifestPlugin satisfies strict JSON requirements.
+func (p *preVdlManifestPlugin) validate(parentPath string) error {
+	vdlPathSrc := "src"
+	if parentPath != "" {
+		vdlPathSrc = parentPath + ".src"
+	}
+	if p.Src == nil {
+		return fmt.Errorf("field %s is required", vdlPathSrc)
+	}
+
+	vdlPathSrcHash := "srcHash"
+	if parentPath != "" {
+		vdlPathSrcHash = parentPath + ".srcHash"
+	}
+	if p.SrcHash == nil {
+		return fmt.Errorf("field %s is required", vdlPathSrcHash)
+	}
+
+	vdlPathSchema := "schema"
+	if parentPath != "" {
+		vdlPathSchema = parentPath + ".schema"
+	}
+	if p.Schema == nil {
+		return fmt.Errorf("field %s is required", vdlPathSchema)
+	}
+
+	vdlPathSchemaHash := "schemaHash"
+	if parentPath != "" {
+		vdlPathSchemaHash = parentPath + ".schemaHash"
+	}
+	if p.SchemaHash == nil {
+		return fmt.Errorf("field %s is required", vdlPathSchemaHash)
+	}
+
+	vdlPathOutDir := "outDir"
+	if parentPath != "" {
+		vdlPathOutDir = parentPath + ".outDir"
+	}
+	if p.OutDir == nil {
+		return fmt.Errorf("field %s is required", vdlPathOutDir)
+	}
+
+	vdlPathFiles := "files"
+	if parentPath != "" {
+		vdlPathFiles = parentPath + ".files"
+	}
+	if p.Files == nil {
+		return fmt.Errorf("field %s is required", vdlPathFiles)
+	}
+	if p.Files != nil {
+		for vdlIndex0, vdlItem0 := range *p.Files {
+			vdlItemPath0 := fmt.Sprintf("%s[%d]", vdlPathFiles, vdlIndex0)
+			if err := vdlItem0.validate(vdlItemPath0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// transform converts preVdlManifestPlugin to VdlManifestPlugin.
+func (p *preVdlManifestPlugin) transform() VdlManifestPlugin {
+	var transSrc string
+	transSrc = *p.Src
+
+	var transSrcHash string
+	transSrcHash = *p.SrcHash
+
+	var transSchema string
+	transSchema = *p.Schema
+
+	var transSchemaHash string
+	transSchemaHash = *p.SchemaHash
+
+	var transOutDir string
+	transOutDir = *p.OutDir
+
+	var transFiles []VdlManifestFile
+	transFiles = make([]VdlManifestFile, len(*p.Files))
+	for vdlIndex0, vdlItem0 := range *p.Files {
+		var vdlTransformed0 VdlManifestFile
+		vdlTransformed0 = VdlManifestFile(vdlItem0.transform())
+		transFiles[vdlIndex0] = vdlTransformed0
+	}
+
+	return VdlManifestPlugin{
+		Src:        transSrc,
+		SrcHash:    transSrcHash,
+		Schema:     transSchema,
+		SchemaHash: transSchemaHash,
+		OutDir:     transOutDir,
+		Files:      transFiles,
+	}
+}
+
+// UnmarshalJSON decodes VdlManifestPlugin while requiring every non-optional JSON field.
+func (x *VdlManifestPlugin) UnmarshalJSON(data []byte) error {
+	var pre preVdlManifestPlugin
+	if err := json.Unmarshal(data, &pre); err != nil {
+		return err
+	}
+	if err := pre.validate(""); err != nil {
+		return err
+	}
+	*x = pre.transform()
+	return nil
+}
+
+// GetSrc returns the Src field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestPlugin) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	var zero string
+	return zero
+}
+
+// GetSrcOr returns the Src field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestPlugin) GetSrcOr(defaultValue string) string {
+	if x != nil {
+		return x.Src
+	}
+	return defaultValue
+}
+
+// GetSrcHash returns the SrcHash field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestPlugin) GetSrcHash() string {
+	if x != nil {
+		return x.SrcHash
+	}
+	var zero string
+	return zero
+}
+
+// GetSrcHashOr returns the SrcHash field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestPlugin) GetSrcHashOr(defaultValue string) string {
+	if x != nil {
+		return x.SrcHash
+	}
+	return defaultValue
+}
+
+// GetSchema returns the Schema field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestPlugin) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	var zero string
+	return zero
+}
+
+// GetSchemaOr returns the Schema field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestPlugin) GetSchemaOr(defaultValue string) string {
+	if x != nil {
+		return x.Schema
+	}
+	return defaultValue
+}
+
+// GetSchemaHash returns the SchemaHash field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestPlugin) GetSchemaHash() string {
+	if x != nil {
+		return x.SchemaHash
+	}
+	var zero string
+	return zero
+}
+
+// GetSchemaHashOr returns the SchemaHash field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestPlugin) GetSchemaHashOr(defaultValue string) string {
+	if x != nil {
+		return x.SchemaHash
+	}
+	return defaultValue
+}
+
+// GetOutDir returns the OutDir field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestPlugin) GetOutDir() string {
+	if x != nil {
+		return x.OutDir
+	}
+	var zero string
+	return zero
+}
+
+// GetOutDirOr returns the OutDir field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestPlugin) GetOutDirOr(defaultValue string) string {
+	if x != nil {
+		return x.OutDir
+	}
+	return defaultValue
+}
+
+// GetFiles returns the Files field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestPlugin) GetFiles() []VdlManifestFile {
+	if x != nil {
+		return x.Files
+	}
+	var zero []VdlManifestFile
+	return zero
+}
+
+// GetFilesOr returns the Files field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestPlugin) GetFilesOr(defaultValue []VdlManifestFile) []VdlManifestFile {
+	if x != nil {
+		return x.Files
+	}
+	return defaultValue
+}
+
+// VDL Manifest File Schema.
+//
+// Lists every artifact written by `vdl generate` together with the inputs
+// that produced it, so build systems and other tooling can discover and
+// verify generated outputs without scanning output directories.
+//
+// Written by VDL tooling to the `vdl.manifest.json` file next to
+// `vdl.config.vdl` when the `manifest` config option is enabled.
+type VdlManifestSchema struct {
+	// The version of the manifest format. This should be set to 1.
+	Version int64 `json:"version"`
+	// The version of the VDL toolchain that generated the artifacts.
+	VdlVersion string `json:"vdlVersion"`
+	// Every configured plugin, in config order, with the files it generated.
+	Plugins []VdlManifestPlugin `json:"plugins"`
+}
+
+// preVdlManifestSchema mirrors VdlManifestSchema during strict JSON decoding.
+type preVdlManifestSchema struct {
+	Version    *int64                  `json:"version"`
+	VdlVersion *string                 `json:"vdlVersion"`
+	Plugins    *[]preVdlManifestPlugin `json:"plugins"`
+}
+
+// validate reports whether preVdlManifestSchema satisfies strict JSON requirements.
+func (p *preVdlManifestSchema) validate(parentPath string) error {
+	vdlPathVersion := "version"
+	if parentPath != "" {
+		vdlPathVersion = parentPath + ".version"
+	}
+	if p.Version == nil {
+		return fmt.Errorf("field %s is required", vdlPathVersion)
+	}
+
+	vdlPathVdlVersion := "vdlVersion"
+	if parentPath != "" {
+		vdlPathVdlVersion = parentPath + ".vdlVersion"
+	}
+	if p.VdlVersion == nil {
+		return fmt.Errorf("field %s is required", vdlPathVdlVersion)
+	}
+
+	vdlPathPlugins := "plugins"
+	if parentPath != "" {
+		vdlPathPlugins = parentPath + ".plugins"
+	}
+	if p.Plugins == nil {
+		return fmt.Errorf("field %s is required", vdlPathPlugins)
+	}
+	if p.Plugins != nil {
+		for vdlIndex0, vdlItem0 := range *p.Plugins {
+			vdlItemPath0 := fmt.Sprintf("%s[%d]", vdlPathPlugins, vdlIndex0)
+			if err := vdlItem0.validate(vdlItemPath0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// transform converts preVdlManifestSchema to VdlManifestSchema.
+func (p *preVdlManifestSchema) transform() VdlManifestSchema {
+	var transVersion int64
+	transVersion = *p.Version
+
+	var transVdlVersion string
+	transVdlVersion = *p.VdlVersion
+
+	var transPlugins []VdlManifestPlugin
+	transPlugins = make([]VdlManifestPlugin, len(*p.Plugins))
+	for vdlIndex0, vdlItem0 := range *p.Plugins {
+		var vdlTransformed0 VdlManifestPlugin
+		vdlTransformed0 = VdlManifestPlugin(vdlItem0.transform())
+		transPlugins[vdlIndex0] = vdlTransformed0
+	}
+
+	return VdlManifestSchema{
+		Version:    transVersion,
+		VdlVersion: transVdlVersion,
+		Plugins:    transPlugins,
+	}
+}
+
+// UnmarshalJSON decodes VdlManifestSchema while requiring every non-optional JSON field.
+func (x *VdlManifestSchema) UnmarshalJSON(data []byte) error {
+	var pre preVdlManifestSchema
+	if err := json.Unmarshal(data, &pre); err != nil {
+		return err
+	}
+	if err := pre.validate(""); err != nil {
+		return err
+	}
+	*x = pre.transform()
+	return nil
+}
+
+// GetVersion returns the Version field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestSchema) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	var zero int64
+	return zero
+}
+
+// GetVersionOr returns the Version field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestSchema) GetVersionOr(defaultValue int64) int64 {
+	if x != nil {
+		return x.Version
+	}
+	return defaultValue
+}
+
+// GetVdlVersion returns the VdlVersion field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestSchema) GetVdlVersion() string {
+	if x != nil {
+		return x.VdlVersion
+	}
+	var zero string
+	return zero
+}
+
+// GetVdlVersionOr returns the VdlVersion field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestSchema) GetVdlVersionOr(defaultValue string) string {
+	if x != nil {
+		return x.VdlVersion
+	}
+	return defaultValue
+}
+
+// GetPlugins returns the Plugins field. It returns the zero value when the receiver is nil.
+func (x *VdlManifestSchema) GetPlugins() []VdlManifestPlugin {
+	if x != nil {
+		return x.Plugins
+	}
+	var zero []VdlManifestPlugin
+	return zero
+}
+
+// GetPluginsOr returns the Plugins field. It returns defaultValue when the receiver is nil.
+func (x *VdlManifestSchema) GetPluginsOr(defaultValue []VdlManifestPlugin) []VdlManifestPlugin {
+	if x != nil {
+		return x.Plugins
+	}
+	return defaultValue
+}
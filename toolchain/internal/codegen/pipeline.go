@@ -19,8 +19,9 @@ import (
 )
 
 // preparePlugins loads plugin scripts from disk and builds the input payload for
-// each configured plugin.
-func preparePlugins(plugins []runtimePlugin) ([]preparedPlugin, error) {
+// each configured plugin. Schema sources are hashed only when hashSchemas is
+// set, since the hashes are only needed for the generation manifest.
+func preparePlugins(plugins []runtimePlugin, hashSchemas bool) ([]preparedPlugin, error) {
 	prepared := make([]preparedPlugin, 0, len(plugins))
 	for _, plugin := range plugins {
 		scriptPath := plugin.Source.LocalPath
@@ -38,10 +39,11 @@ func preparePlugins(plugins []runtimePlugin) ([]preparedPlugin, error) {
 		}
 		plugin.Source.ContentHash = sha256Digest(scriptBytes)
 
-		input, err := buildPluginInput(plugin)
+		input, schemaHash, err := buildPluginInput(plugin, hashSchemas)
 		if err != nil {
 			return nil, err
 		}
+		plugin.SchemaHash = schemaHash
 
 		prepared = append(prepared, preparedPlugin{
 			Plugin: plugin,
@@ -54,18 +56,28 @@ func preparePlugins(plugins []runtimePlugin) ([]preparedPlugin, error) {
 }
 
 // buildPluginInput analyzes the plugin schema and converts its IR into the
-// generated plugin input types.
-func buildPluginInput(plugin runtimePlugin) (plugintypes.PluginInput, error) {
+// generated plugin input types. When hashSchema is set, it also returns the
+// hash of the loaded schema sources for the generation manifest.
+func buildPluginInput(plugin runtimePlugin, hashSchema bool) (plugintypes.PluginInput, string, error) {
 	fs := vfs.New()
 	program, diagnostics := analysis.Analyze(fs, plugin.SchemaPath)
 	if len(diagnostics) > 0 {
-		return plugintypes.PluginInput{}, diagnosticsToError(diagnostics)
+		return plugintypes.PluginInput{}, "", diagnosticsToError(diagnostics)
+	}
+
+	var schemaHash string
+	if hashSchema {
+		var err error
+		schemaHash, err = hashProgramSources(fs, program, filepath.Dir(plugin.SchemaPath))
+		if err != nil {
+			return plugintypes.PluginInput{}, "", err
+		}
 	}
 
 	schema := ir.FromProgram(program)
 	pluginIR, err := convertIRSchema(schema)
 	if err != nil {
-		return plugintypes.PluginInput{}, fmt.Errorf(
+		return plugintypes.PluginInput{}, "", fmt.Errorf(
 			"failed to build plugin IR for %q: %w",
 			plugin.Source.DisplayName,
 			err,
@@ -76,7 +88,7 @@ func buildPluginInput(plugin runtimePlugin) (plugintypes.PluginInput, error) {
 		Version: version.Version,
 		Ir:      pluginIR,
 		Options: cloneStringMap(plugin.Options),
-	}, nil
+	}, schemaHash, nil
 }
 
 // convertIRSchema converts the core IR representation into the generated plugin
@@ -197,6 +209,7 @@ func planOutputWrites(results []executedPlugin) (outputPlan, error) {
 			seenPaths[absolutePath] = result.Plugin.Source.DisplayName
 
 			plan.Writes = append(plan.Writes, outputWrite{
+				PluginIndex:  result.Plugin.Index,
 				PluginName:   result.Plugin.Source.DisplayName,
 				OutDir:       result.Plugin.OutDir,
 				RelativePath: relativePath,
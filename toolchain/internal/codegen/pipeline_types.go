@@ -33,6 +33,7 @@ type runtimePlugin struct {
 	OutDir         string
 	Options        map[string]string
 	GenerateHeader bool
+	SchemaHash     string
 }
 
 type preparedPlugin struct {
@@ -47,6 +48,7 @@ type executedPlugin struct {
 }
 
 type outputWrite struct {
+	PluginIndex  int
 	PluginName   string
 	OutDir       string
 	RelativePath string
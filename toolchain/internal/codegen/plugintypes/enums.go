@@ -134,6 +134,9 @@ const (
 	PrimitiveTypeFloat    PrimitiveType = "float"
 	PrimitiveTypeBool     PrimitiveType = "bool"
 	PrimitiveTypeDatetime PrimitiveType = "datetime"
+	PrimitiveTypeDuration PrimitiveType = "duration"
+	PrimitiveTypeUuid     PrimitiveType = "uuid"
+	PrimitiveTypeBytes    PrimitiveType = "bytes"
 )
 
 // PrimitiveTypeList contains every valid PrimitiveType value.
@@ -143,6 +146,9 @@ var PrimitiveTypeList = []PrimitiveType{
 	PrimitiveTypeFloat,
 	PrimitiveTypeBool,
 	PrimitiveTypeDatetime,
+	PrimitiveTypeDuration,
+	PrimitiveTypeUuid,
+	PrimitiveTypeBytes,
 }
 
 // String returns a readable representation of PrimitiveType.
@@ -153,7 +159,7 @@ func (e PrimitiveType) String() string {
 // IsValid reports whether the value belongs to PrimitiveType.
 func (e PrimitiveType) IsValid() bool {
 	switch e {
-	case PrimitiveTypeString, PrimitiveTypeInt, PrimitiveTypeFloat, PrimitiveTypeBool, PrimitiveTypeDatetime:
+	case PrimitiveTypeString, PrimitiveTypeInt, PrimitiveTypeFloat, PrimitiveTypeBool, PrimitiveTypeDatetime, PrimitiveTypeDuration, PrimitiveTypeUuid, PrimitiveTypeBytes:
 		return true
 	}
 	return false
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/varavelio/vdl/toolchain/internal/codegen/configtypes"
+	"github.com/varavelio/vdl/toolchain/internal/codegen/manifesttypes"
 )
 
 // Run executes the full code generation pipeline and returns the number of
@@ -17,10 +18,11 @@ func Run(configPath string, checkOnly bool) (int, error) {
 }
 
 type runtimeConfig struct {
-	Path     string
-	Dir      string
-	LockPath string
-	Config   configtypes.VdlConfig
+	Path         string
+	Dir          string
+	LockPath     string
+	ManifestPath string
+	Config       configtypes.VdlConfig
 }
 
 // runWithConfig orchestrates the generation pipeline after the config file has
@@ -44,7 +46,11 @@ func runWithConfig(config runtimeConfig, checkOnly bool) (int, error) {
 		return 0, err
 	}
 
-	preparedPlugins, err := preparePlugins(plugins)
+	// The manifest, and the schema hashes it records, are only computed when
+	// the manifest is actually written.
+	writeManifest := !checkOnly && config.Config.GetManifestOr(false)
+
+	preparedPlugins, err := preparePlugins(plugins, writeManifest)
 	if err != nil {
 		return 0, err
 	}
@@ -60,6 +66,14 @@ func runWithConfig(config runtimeConfig, checkOnly bool) (int, error) {
 		return 0, err
 	}
 
+	var manifest manifesttypes.VdlManifestSchema
+	if writeManifest {
+		manifest, err = buildManifest(config, results, plan)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	if !checkOnly {
 		if err := writeLockFile(config.LockPath, lockFile); err != nil {
 			return 0, err
@@ -69,6 +83,14 @@ func runWithConfig(config runtimeConfig, checkOnly bool) (int, error) {
 			return 0, err
 		}
 
+		if writeManifest {
+			if err := writeManifestFile(config.ManifestPath, manifest); err != nil {
+				return 0, err
+			}
+		} else if err := removeManifestFile(config.ManifestPath); err != nil {
+			return 0, err
+		}
+
 		runPostGenerateHooks(config)
 	}
 
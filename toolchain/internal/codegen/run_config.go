@@ -61,10 +61,11 @@ func loadRuntimeConfig(inputPath string) (runtimeConfig, error) {
 	configDir := filepath.Dir(configPath)
 
 	return runtimeConfig{
-		Path:     configPath,
-		Dir:      configDir,
-		LockPath: filepath.Join(configDir, defaultLockFileName),
-		Config:   config,
+		Path:         configPath,
+		Dir:          configDir,
+		LockPath:     filepath.Join(configDir, defaultLockFileName),
+		ManifestPath: filepath.Join(configDir, defaultManifestFileName),
+		Config:       config,
 	}, nil
 }
 
@@ -181,12 +182,23 @@ func literalValueToAny(value irtypes.LiteralValue) (any, error) {
 	}
 }
 
-// diagnosticsToError joins analysis diagnostics into a single error value that
-// preserves the original one-per-line formatting.
-func diagnosticsToError(diagnostics []analysis.Diagnostic) error {
-	parts := make([]string, len(diagnostics))
-	for i, diag := range diagnostics {
+// DiagnosticsError is returned when schema or config analysis fails. It keeps
+// the original diagnostics so callers can render them in structured formats,
+// while Error preserves the one-per-line text formatting.
+type DiagnosticsError struct {
+	Diagnostics []analysis.Diagnostic
+}
+
+// Error joins the diagnostics using their canonical text representation.
+func (e *DiagnosticsError) Error() string {
+	parts := make([]string, len(e.Diagnostics))
+	for i, diag := range e.Diagnostics {
 		parts[i] = diag.Error()
 	}
-	return errors.New(strings.Join(parts, "\n"))
+	return strings.Join(parts, "\n")
+}
+
+// diagnosticsToError wraps analysis diagnostics into a DiagnosticsError.
+func diagnosticsToError(diagnostics []analysis.Diagnostic) error {
+	return &DiagnosticsError{Diagnostics: diagnostics}
 }
@@ -112,6 +112,40 @@ func TestRunRejectsTraversalBeforeCleaningOutputs(t *testing.T) {
 	require.NoFileExists(t, filepath.Join(dir, "escape.txt"))
 }
 
+func TestRunReturnsStructuredSchemaDiagnostics(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "schema.vdl"), "type User {\n  name Missing\n  role Unknown\n}\n")
+	writeTestFile(
+		t,
+		filepath.Join(dir, "plugin/index.js"),
+		`exports.generate = () => ({ files: [] })`,
+	)
+	writeTestFile(t, filepath.Join(dir, defaultConfigFileName), `
+		const config = {
+			version 1
+			plugins [
+				{
+					src "./plugin/index.js"
+					schema "./schema.vdl"
+					outDir "./gen"
+				}
+			]
+		}
+	`)
+
+	_, err := Run(dir, false)
+	require.Error(t, err)
+
+	var diagErr *DiagnosticsError
+	require.ErrorAs(t, err, &diagErr)
+	require.Len(t, diagErr.Diagnostics, 2)
+	require.Equal(t, "E201", diagErr.Diagnostics[0].Code)
+	require.Equal(t, 2, diagErr.Diagnostics[0].Pos.Line)
+	require.Equal(t, "E201", diagErr.Diagnostics[1].Code)
+	require.Equal(t, 3, diagErr.Diagnostics[1].Pos.Line)
+	require.Equal(t, diagErr.Diagnostics[0].Error()+"\n"+diagErr.Diagnostics[1].Error(), err.Error())
+}
+
 func TestRunWithRemotePluginUsesCacheAndWritesLockFile(t *testing.T) {
 	dir := t.TempDir()
 	t.Setenv("VDL_HOME", filepath.Join(dir, ".vdl-home"))
@@ -0,0 +1,58 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+)
+
+// WatchedFiles returns the absolute paths whose changes affect the output of
+// Run for the given config: the config file itself, every plugin schema
+// together with the files it includes, and local plugin scripts.
+//
+// Schemas with errors still contribute the files that could be resolved, so a
+// watcher keeps tracking them and can regenerate once they are fixed. In the
+// same spirit, a config file that exists but fails to load is returned on its
+// own together with the error.
+func WatchedFiles(configPath string) ([]string, error) {
+	resolvedPath, err := resolveConfigFilePath(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	runtimeConfig, err := loadRuntimeConfig(resolvedPath)
+	if err != nil {
+		return []string{resolvedPath}, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	plugins, err := resolveRuntimePlugins(runtimeConfig)
+	if err != nil {
+		return []string{runtimeConfig.Path}, err
+	}
+
+	seen := map[string]bool{runtimeConfig.Path: true}
+	fs := vfs.New()
+	for _, plugin := range plugins {
+		if plugin.Source.Kind == pluginSourceKindLocal {
+			seen[plugin.Source.LocalPath] = true
+		}
+
+		seen[plugin.SchemaPath] = true
+		program, _ := analysis.Analyze(fs, plugin.SchemaPath)
+		if program == nil {
+			continue
+		}
+		for path := range program.Files {
+			seen[path] = true
+		}
+	}
+
+	files := make([]string, 0, len(seen))
+	for path := range seen {
+		files = append(files, path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,76 @@
+package codegen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchedFiles(t *testing.T) {
+	t.Run("includes config, schemas with includes, and local plugins", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTestFile(t, filepath.Join(dir, "schema.vdl"), "include \"./common.vdl\"\n\ntype User {\n  id Id\n}\n")
+		writeTestFile(t, filepath.Join(dir, "common.vdl"), "type Id string\n")
+		writeTestFile(t, filepath.Join(dir, "plugin/index.js"), `exports.generate = () => ({ files: [] })`)
+		writeTestFile(t, filepath.Join(dir, defaultConfigFileName), `
+			const config = {
+				version 1
+				plugins [
+					{
+						src "./plugin/index.js"
+						schema "./schema.vdl"
+						outDir "./gen"
+					}
+				]
+			}
+		`)
+
+		files, err := WatchedFiles(dir)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			filepath.Join(dir, "common.vdl"),
+			filepath.Join(dir, "plugin/index.js"),
+			filepath.Join(dir, "schema.vdl"),
+			filepath.Join(dir, defaultConfigFileName),
+		}, files)
+	})
+
+	t.Run("keeps schemas with errors", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTestFile(t, filepath.Join(dir, "schema.vdl"), "include \"./common.vdl\"\n\ntype User {\n  id Missing\n}\n")
+		writeTestFile(t, filepath.Join(dir, "common.vdl"), "type Id string\n")
+		writeTestFile(t, filepath.Join(dir, defaultConfigFileName), `
+			const config = {
+				version 1
+				plugins [
+					{
+						src "./plugin/index.js"
+						schema "./schema.vdl"
+						outDir "./gen"
+					}
+				]
+			}
+		`)
+
+		files, err := WatchedFiles(dir)
+		require.NoError(t, err)
+		require.Contains(t, files, filepath.Join(dir, "common.vdl"))
+		require.Contains(t, files, filepath.Join(dir, "schema.vdl"))
+	})
+
+	t.Run("returns the config path when the config is invalid", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := writeConfigFile(t, dir, "const config = {\n")
+
+		files, err := WatchedFiles(dir)
+		require.Error(t, err)
+		require.Equal(t, []string{configPath}, files)
+	})
+
+	t.Run("fails when no config exists", func(t *testing.T) {
+		files, err := WatchedFiles(t.TempDir())
+		require.Error(t, err)
+		require.Empty(t, files)
+	})
+}
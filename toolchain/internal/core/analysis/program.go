@@ -89,7 +89,7 @@ type FieldTypeInfo struct {
 type FieldTypeKind int
 
 const (
-	FieldTypeKindPrimitive FieldTypeKind = iota // string, int, float, bool, datetime
+	FieldTypeKindPrimitive FieldTypeKind = iota // string, int, float, bool, datetime, duration, uuid, bytes
 	FieldTypeKindCustom                         // Reference to a custom type
 	FieldTypeKindMap                            // map<ValueType>
 	FieldTypeKindObject                         // Inline object { ... }
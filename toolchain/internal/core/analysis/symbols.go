@@ -117,10 +117,10 @@ func (st *symbolTable) allTypeNames() []string {
 // allFieldTypeNames returns all valid type names for field types.
 // This includes primitive types, custom types, and enums.
 func (st *symbolTable) allFieldTypeNames() []string {
-	names := make([]string, 0, len(st.types)+len(st.enums)+5)
+	names := make([]string, 0, len(st.types)+len(st.enums)+len(ast.PrimitiveTypes))
 
 	// Add primitive types
-	names = append(names, "string", "int", "float", "bool", "datetime")
+	names = append(names, ast.PrimitiveTypes...)
 
 	// Add custom types
 	for name := range st.types {
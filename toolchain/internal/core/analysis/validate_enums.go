@@ -54,20 +54,22 @@ func validateEnum(symbols *symbolTable, enum *EnumSymbol) []Diagnostic {
 		}
 	}
 
-	// Check for duplicate values
-	memberValues := make(map[string]string) // value -> member name
+	// Check for duplicate values. Int values are compared numerically so that
+	// spellings such as 1 and 01, which produce the same IR value, collide.
+	memberValues := make(map[string]*EnumMemberSymbol) // normalized value -> member
 	for _, member := range effectiveMembers {
-		if existingName, ok := memberValues[member.Value]; ok {
+		key := normalizeEnumValue(member.Value, enum.ValueType)
+		if existing, ok := memberValues[key]; ok {
 			diagnostics = append(diagnostics, newDiagnostic(
 				enum.File,
 				member.Pos,
 				member.EndPos,
 				CodeEnumDuplicateValue,
-				fmt.Sprintf("enum member %q has the same value %q as member %q",
-					member.Name, member.Value, existingName),
+				fmt.Sprintf("enum member %q has the same value %q as member %q declared at %s:%d:%d",
+					member.Name, member.Value, existing.Name, existing.File, existing.Pos.Line, existing.Pos.Column),
 			))
 		} else {
-			memberValues[member.Value] = member.Name
+			memberValues[key] = member
 		}
 	}
 
@@ -260,6 +262,19 @@ func expandEnumMembers(
 	return effectiveMembers, valueType, diagnostics
 }
 
+// normalizeEnumValue returns the canonical form of a member value used for
+// duplicate detection.
+func normalizeEnumValue(value string, valueType EnumValueType) string {
+	if valueType != EnumValueTypeInt {
+		return value
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	return strconv.FormatInt(n, 10)
+}
+
 func inferEnumValueType(members []*EnumMemberSymbol) EnumValueType {
 	for _, m := range members {
 		if !m.HasExplicit {
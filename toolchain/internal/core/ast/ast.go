@@ -66,6 +66,9 @@ const (
 	PrimitiveTypeFloat    PrimitiveType = "float"
 	PrimitiveTypeBool     PrimitiveType = "bool"
 	PrimitiveTypeDatetime PrimitiveType = "datetime"
+	PrimitiveTypeDuration PrimitiveType = "duration"
+	PrimitiveTypeUuid     PrimitiveType = "uuid"
+	PrimitiveTypeBytes    PrimitiveType = "bytes"
 )
 
 // PrimitiveTypes is a list of primitive types that are not
@@ -76,6 +79,9 @@ var PrimitiveTypes = []PrimitiveType{
 	PrimitiveTypeFloat,
 	PrimitiveTypeBool,
 	PrimitiveTypeDatetime,
+	PrimitiveTypeDuration,
+	PrimitiveTypeUuid,
+	PrimitiveTypeBytes,
 }
 
 // IsPrimitiveType checks if a type is a primitive type.
@@ -202,7 +208,7 @@ type Include struct {
 // Annotation represents metadata attached to declarations and fields.
 type Annotation struct {
 	Positions
-	Name     string       `parser:"At @Ident"`
+	Name     string       `parser:"At @(Ident | Duration | Uuid | Bytes)"`
 	Argument *DataLiteral `parser:"(LParen @@ RParen)?"`
 }
 
@@ -263,7 +269,7 @@ type ConstDecl struct {
 	Positions
 	Docstring   *Docstring    `parser:"(@@ (?! Newline Newline))?"`
 	Annotations []*Annotation `parser:"@@*"`
-	Name        string        `parser:"Const @Ident"`
+	Name        string        `parser:"Const @(Ident | Duration | Uuid | Bytes)"`
 	Value       *DataLiteral  `parser:"Equals @@"`
 }
 
@@ -285,7 +291,7 @@ type EnumMember struct {
 	Spread      *Spread       `parser:"  @@"`
 	Docstring   *Docstring    `parser:"| (@@ (?! Newline Newline))?"`
 	Annotations []*Annotation `parser:"  @@*"`
-	Name        string        `parser:"  @(Ident | Include | Const | Enum | Map | Type | String | Int | Float | Bool | Datetime | True | False)"`
+	Name        string        `parser:"  @(Ident | Include | Const | Enum | Map | Type | String | Int | Float | Bool | Datetime | Duration | Uuid | Bytes | True | False)"`
 	Value       *EnumValue    `parser:"  (Equals @@)?"`
 }
 
@@ -340,7 +346,7 @@ type Field struct {
 	Positions
 	Docstring   *Docstring    `parser:"(@@ (?! Newline Newline))?"`
 	Annotations []*Annotation `parser:"@@*"`
-	Name        string        `parser:"@(Ident | Include | Const | Enum | Map | Type | String | Int | Float | Bool | Datetime | True | False)"`
+	Name        string        `parser:"@(Ident | Include | Const | Enum | Map | Type | String | Int | Float | Bool | Datetime | Duration | Uuid | Bytes | True | False)"`
 	Optional    bool          `parser:"@Question?"`
 	Type        FieldType     `parser:"@@"`
 }
@@ -389,8 +395,8 @@ func (ft *FieldType) IsArray() bool {
 // FieldTypeBase represents the base type of a field (named, map, or inline object).
 type FieldTypeBase struct {
 	Positions
-	// Named can be a primitive type (string, int, float, bool, datetime) or a custom type name
-	Named  *string          `parser:"  @(Ident | String | Int | Float | Bool | Datetime)"`
+	// Named can be a primitive type (string, int, float, bool, datetime, duration, uuid, bytes) or a custom type name
+	Named  *string          `parser:"  @(Ident | String | Int | Float | Bool | Datetime | Duration | Uuid | Bytes)"`
 	Map    *FieldTypeMap    `parser:"| @@"`
 	Object *FieldTypeObject `parser:"| @@"`
 }
@@ -425,7 +431,7 @@ type DataLiteralObject struct {
 type DataLiteralObjectEntry struct {
 	Positions
 	Spread *Spread      `parser:"  @@"`
-	Key    string       `parser:"| @(Ident | Include | Const | Enum | Map | Type | String | Int | Float | Bool | Datetime | True | False)"`
+	Key    string       `parser:"| @(Ident | Include | Const | Enum | Map | Type | String | Int | Float | Bool | Datetime | Duration | Uuid | Bytes | True | False)"`
 	Value  *DataLiteral `parser:"@@"`
 }
 
@@ -473,8 +479,8 @@ func (al ScalarLiteral) String() string {
 // Examples: FOO (const ref), Color.Red (enum member ref).
 type Reference struct {
 	Positions
-	Name   string  `parser:"@Ident"`
-	Member *string `parser:"(Dot @Ident)?"`
+	Name   string  `parser:"@(Ident | Duration | Uuid | Bytes)"`
+	Member *string `parser:"(Dot @(Ident | Duration | Uuid | Bytes))?"`
 }
 
 // String returns the string representation of the reference.
@@ -255,6 +255,12 @@ func convertPrimitiveType(name string) irtypes.PrimitiveType {
 		return irtypes.PrimitiveTypeBool
 	case "datetime":
 		return irtypes.PrimitiveTypeDatetime
+	case "duration":
+		return irtypes.PrimitiveTypeDuration
+	case "uuid":
+		return irtypes.PrimitiveTypeUuid
+	case "bytes":
+		return irtypes.PrimitiveTypeBytes
 	default:
 		return irtypes.PrimitiveTypeString
 	}
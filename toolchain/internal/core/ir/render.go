@@ -0,0 +1,202 @@
+package ir
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/varavelio/vdl/toolchain/internal/core/ir/irtypes"
+)
+
+// ToVDL renders an IR schema back into VDL source.
+//
+// The IR is flat, so the output is a single self-contained file: spreads
+// appear expanded, constant references appear as their resolved values, and
+// declarations follow IR order (standalone docs, constants, enums, types).
+// For IR produced by compiling VDL, compiling the result yields the same IR,
+// positions aside.
+//
+// The output is valid VDL but not canonically laid out; run it through the
+// formatter when it is meant for humans.
+func ToVDL(schema *irtypes.IrSchema) string {
+	if schema == nil {
+		return ""
+	}
+
+	r := &vdlRenderer{}
+	for _, doc := range schema.Docs {
+		r.doc(doc.Content, "")
+		r.b.WriteString("\n")
+	}
+	for _, cnst := range schema.Constants {
+		r.doc(derefString(cnst.Doc), "")
+		r.annotations(cnst.Annotations, "")
+		r.b.WriteString("const " + cnst.Name + " = ")
+		r.literal(cnst.Value, "")
+		r.b.WriteString("\n\n")
+	}
+	for _, enum := range schema.Enums {
+		r.enum(enum)
+	}
+	for _, typ := range schema.Types {
+		r.doc(derefString(typ.Doc), "")
+		r.annotations(typ.Annotations, "")
+		r.b.WriteString("type " + typ.Name + " ")
+		r.typeRef(typ.TypeRef, "")
+		r.b.WriteString("\n\n")
+	}
+
+	return strings.TrimRight(r.b.String(), "\n") + "\n"
+}
+
+type vdlRenderer struct {
+	b strings.Builder
+}
+
+const vdlIndent = "  "
+
+func (r *vdlRenderer) doc(content, indent string) {
+	if content == "" {
+		return
+	}
+	if !strings.Contains(content, "\n") {
+		r.b.WriteString(indent + `""" ` + content + " \"\"\"\n")
+		return
+	}
+	r.b.WriteString(indent + "\"\"\"\n")
+	for line := range strings.SplitSeq(content, "\n") {
+		if line == "" {
+			r.b.WriteString("\n")
+			continue
+		}
+		r.b.WriteString(indent + line + "\n")
+	}
+	r.b.WriteString(indent + "\"\"\"\n")
+}
+
+func (r *vdlRenderer) annotations(annotations []irtypes.Annotation, indent string) {
+	for _, ann := range annotations {
+		r.b.WriteString(indent + "@" + ann.Name)
+		if ann.Argument != nil {
+			r.b.WriteString("(")
+			r.literal(*ann.Argument, indent)
+			r.b.WriteString(")")
+		}
+		r.b.WriteString("\n")
+	}
+}
+
+func (r *vdlRenderer) enum(enum irtypes.EnumDef) {
+	r.doc(derefString(enum.Doc), "")
+	r.annotations(enum.Annotations, "")
+	r.b.WriteString("enum " + enum.Name + " {\n")
+	for _, member := range enum.Members {
+		r.doc(derefString(member.Doc), vdlIndent)
+		r.annotations(member.Annotations, vdlIndent)
+		r.b.WriteString(vdlIndent + member.Name)
+		switch member.Value.Kind {
+		case irtypes.LiteralKindString:
+			if value := member.Value.GetStringValue(); value != member.Name {
+				r.b.WriteString(` = "` + value + `"`)
+			}
+		case irtypes.LiteralKindInt:
+			r.b.WriteString(" = " + strconv.FormatInt(member.Value.GetIntValue(), 10))
+		}
+		r.b.WriteString("\n")
+	}
+	r.b.WriteString("}\n\n")
+}
+
+func (r *vdlRenderer) typeRef(ref irtypes.TypeRef, indent string) {
+	switch ref.Kind {
+	case irtypes.TypeKindPrimitive:
+		r.b.WriteString(ref.GetPrimitiveName().String())
+	case irtypes.TypeKindType:
+		r.b.WriteString(ref.GetTypeName())
+	case irtypes.TypeKindEnum:
+		r.b.WriteString(ref.GetEnumName())
+	case irtypes.TypeKindArray:
+		if ref.ArrayType != nil {
+			r.typeRef(*ref.ArrayType, indent)
+		}
+		r.b.WriteString(strings.Repeat("[]", int(max(ref.GetArrayDims(), 1))))
+	case irtypes.TypeKindMap:
+		r.b.WriteString("map[")
+		if ref.MapType != nil {
+			r.typeRef(*ref.MapType, indent)
+		}
+		r.b.WriteString("]")
+	case irtypes.TypeKindObject:
+		fields := ref.GetObjectFields()
+		if len(fields) == 0 {
+			r.b.WriteString("{}")
+			return
+		}
+		r.b.WriteString("{\n")
+		inner := indent + vdlIndent
+		for _, field := range fields {
+			r.doc(derefString(field.Doc), inner)
+			r.annotations(field.Annotations, inner)
+			r.b.WriteString(inner + field.Name)
+			if field.Optional {
+				r.b.WriteString("?")
+			}
+			r.b.WriteString(" ")
+			r.typeRef(field.TypeRef, inner)
+			r.b.WriteString("\n")
+		}
+		r.b.WriteString(indent + "}")
+	}
+}
+
+func (r *vdlRenderer) literal(value irtypes.LiteralValue, indent string) {
+	switch value.Kind {
+	case irtypes.LiteralKindString:
+		// String payloads keep their source escapes, so they are written back verbatim.
+		r.b.WriteString(`"` + value.GetStringValue() + `"`)
+	case irtypes.LiteralKindInt:
+		r.b.WriteString(strconv.FormatInt(value.GetIntValue(), 10))
+	case irtypes.LiteralKindFloat:
+		formatted := strconv.FormatFloat(value.GetFloatValue(), 'f', -1, 64)
+		if !strings.Contains(formatted, ".") {
+			formatted += ".0"
+		}
+		r.b.WriteString(formatted)
+	case irtypes.LiteralKindBool:
+		r.b.WriteString(strconv.FormatBool(value.GetBoolValue()))
+	case irtypes.LiteralKindObject:
+		entries := value.GetObjectEntries()
+		if len(entries) == 0 {
+			r.b.WriteString("{}")
+			return
+		}
+		r.b.WriteString("{\n")
+		inner := indent + vdlIndent
+		for _, entry := range entries {
+			r.b.WriteString(inner + entry.Key + " ")
+			r.literal(entry.Value, inner)
+			r.b.WriteString("\n")
+		}
+		r.b.WriteString(indent + "}")
+	case irtypes.LiteralKindArray:
+		items := value.GetArrayItems()
+		if len(items) == 0 {
+			r.b.WriteString("[]")
+			return
+		}
+		r.b.WriteString("[\n")
+		inner := indent + vdlIndent
+		for _, item := range items {
+			r.b.WriteString(inner)
+			r.literal(item, inner)
+			r.b.WriteString("\n")
+		}
+		r.b.WriteString(indent + "]")
+	}
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
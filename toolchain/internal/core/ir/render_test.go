@@ -0,0 +1,50 @@
+package ir
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+	"github.com/varavelio/vdl/toolchain/internal/util/testutil"
+)
+
+// TestToVDL_RoundTrip renders every golden fixture back to VDL and checks that
+// compiling the rendered source yields the same IR.
+func TestToVDL_RoundTrip(t *testing.T) {
+	inputs, err := filepath.Glob("testdata/*.vdl")
+	require.NoError(t, err)
+	require.NotEmpty(t, inputs)
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".vdl")
+		t.Run(name, func(t *testing.T) {
+			absInput, err := filepath.Abs(input)
+			require.NoError(t, err)
+
+			program, diags := analysis.Analyze(vfs.New(), absInput)
+			require.Empty(t, diags, "analysis errors: %v", diags)
+			original := FromProgram(program)
+
+			rendered := ToVDL(original)
+
+			renderedPath := filepath.Join(t.TempDir(), "rendered.vdl")
+			fs := vfs.New()
+			fs.WriteFileCache(renderedPath, []byte(rendered))
+			program, diags = analysis.Analyze(fs, renderedPath)
+			require.Empty(t, diags, "rendered source does not compile: %v\n%s", diags, rendered)
+
+			roundTrip := FromProgram(program)
+			roundTrip.EntryPoint = original.EntryPoint
+
+			want, err := json.Marshal(original)
+			require.NoError(t, err)
+			got, err := json.Marshal(roundTrip)
+			require.NoError(t, err)
+			testutil.IRJSONEqualNoPos(t, want, got, "rendered source:\n%s", rendered)
+		})
+	}
+}
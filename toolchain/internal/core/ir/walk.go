@@ -0,0 +1,118 @@
+package ir
+
+import (
+	"slices"
+
+	"github.com/varavelio/vdl/toolchain/internal/core/ir/irtypes"
+)
+
+// Visitor holds the callbacks invoked by Walk. Nil callbacks are skipped.
+//
+// Callbacks that return a bool control descent: returning false skips the
+// children of the visited node. Paths start with the declaring type name and
+// list every enclosing field name, for example ["Users", "getUser", "input"].
+// Annotation paths name the annotated node the same way: ["User"] for a type,
+// ["User", "email"] for a field, ["Color", "Red"] for an enum member and
+// ["maxSize"] for a constant. Path slices are owned by the callback and may be
+// retained.
+type Visitor struct {
+	Type       func(typ *irtypes.TypeDef) bool
+	Field      func(path []string, field *irtypes.Field) bool
+	TypeRef    func(path []string, ref *irtypes.TypeRef) bool
+	Enum       func(enum *irtypes.EnumDef) bool
+	EnumMember func(enum *irtypes.EnumDef, member *irtypes.EnumMember)
+	Constant   func(constant *irtypes.ConstantDef)
+	Annotation func(path []string, annotation *irtypes.Annotation)
+}
+
+// Walk traverses the schema in declaration order: types (including inline
+// object fields at any depth), then enums and their members, then constants.
+// The annotations of each node are visited right after the node itself, unless
+// its callback skips it.
+//
+// Literal values (constant values, enum member values and annotation
+// arguments) are not traversed; callers read them from the visited node.
+//
+// References to named types (`kind: type`) are reported to TypeRef but never
+// followed, so every node is visited exactly once and recursive types such as
+// `type Node { children Node[] }` cannot cause infinite loops. Callers that
+// need to follow references can look up schema.Types by TypeRef.TypeName.
+func Walk(schema *irtypes.IrSchema, visitor Visitor) {
+	if schema == nil {
+		return
+	}
+
+	for i := range schema.Types {
+		typ := &schema.Types[i]
+		if visitor.Type != nil && !visitor.Type(typ) {
+			continue
+		}
+		walkAnnotations(typ.Annotations, []string{typ.Name}, visitor)
+		walkTypeRef(&typ.TypeRef, []string{typ.Name}, visitor)
+	}
+
+	for i := range schema.Enums {
+		enum := &schema.Enums[i]
+		if visitor.Enum != nil && !visitor.Enum(enum) {
+			continue
+		}
+		walkAnnotations(enum.Annotations, []string{enum.Name}, visitor)
+		for j := range enum.Members {
+			member := &enum.Members[j]
+			if visitor.EnumMember != nil {
+				visitor.EnumMember(enum, member)
+			}
+			walkAnnotations(member.Annotations, []string{enum.Name, member.Name}, visitor)
+		}
+	}
+
+	for i := range schema.Constants {
+		constant := &schema.Constants[i]
+		if visitor.Constant != nil {
+			visitor.Constant(constant)
+		}
+		walkAnnotations(constant.Annotations, []string{constant.Name}, visitor)
+	}
+}
+
+func walkAnnotations(annotations []irtypes.Annotation, path []string, visitor Visitor) {
+	if visitor.Annotation == nil {
+		return
+	}
+	for i := range annotations {
+		visitor.Annotation(slices.Clone(path), &annotations[i])
+	}
+}
+
+func walkTypeRef(ref *irtypes.TypeRef, path []string, visitor Visitor) {
+	if ref == nil {
+		return
+	}
+	if visitor.TypeRef != nil && !visitor.TypeRef(slices.Clone(path), ref) {
+		return
+	}
+
+	switch ref.Kind {
+	case irtypes.TypeKindArray:
+		walkTypeRef(ref.ArrayType, path, visitor)
+	case irtypes.TypeKindMap:
+		walkTypeRef(ref.MapType, path, visitor)
+	case irtypes.TypeKindObject:
+		if ref.ObjectFields == nil {
+			return
+		}
+		fields := *ref.ObjectFields
+		for i := range fields {
+			walkField(&fields[i], path, visitor)
+		}
+	}
+}
+
+func walkField(field *irtypes.Field, parentPath []string, visitor Visitor) {
+	path := append(slices.Clone(parentPath), field.Name)
+	if visitor.Field != nil && !visitor.Field(slices.Clone(path), field) {
+		return
+	}
+	walkAnnotations(field.Annotations, path, visitor)
+	walkTypeRef(&field.TypeRef, path, visitor)
+}
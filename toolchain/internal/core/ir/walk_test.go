@@ -0,0 +1,130 @@
+package ir
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
+	"github.com/varavelio/vdl/toolchain/internal/core/ir/irtypes"
+	"github.com/varavelio/vdl/toolchain/internal/core/vfs"
+)
+
+func TestWalk(t *testing.T) {
+	fs := vfs.New()
+	content := `
+@entity
+type Base {
+  @id
+  id string
+}
+
+type TreeNode {
+  ...Base
+  children? TreeNode[]
+  meta {
+    tags map[string]
+    owner {
+      name string
+    }
+  }
+}
+
+@deprecated
+enum Color {
+  @default
+  Red
+  Green
+}
+
+@limit
+const maxDepth = 10
+`
+	absPath := "/test/walk.vdl"
+	fs.WriteFileCache(absPath, []byte(content))
+
+	program, diags := analysis.Analyze(fs, absPath)
+	require.Empty(t, diags)
+	schema := FromProgram(program)
+
+	t.Run("visits every node once", func(t *testing.T) {
+		var types, fields, refs, enums, members, constants, annotations []string
+		Walk(schema, Visitor{
+			Type: func(typ *irtypes.TypeDef) bool {
+				types = append(types, typ.Name)
+				return true
+			},
+			Field: func(path []string, field *irtypes.Field) bool {
+				fields = append(fields, strings.Join(path, "."))
+				return true
+			},
+			TypeRef: func(path []string, ref *irtypes.TypeRef) bool {
+				if ref.Kind == irtypes.TypeKindType {
+					refs = append(refs, strings.Join(path, ".")+"->"+ref.GetTypeName())
+				}
+				return true
+			},
+			Enum: func(enum *irtypes.EnumDef) bool {
+				enums = append(enums, enum.Name)
+				return true
+			},
+			EnumMember: func(enum *irtypes.EnumDef, member *irtypes.EnumMember) {
+				members = append(members, enum.Name+"."+member.Name)
+			},
+			Constant: func(constant *irtypes.ConstantDef) {
+				constants = append(constants, constant.Name)
+			},
+			Annotation: func(path []string, annotation *irtypes.Annotation) {
+				annotations = append(annotations, strings.Join(path, ".")+"@"+annotation.Name)
+			},
+		})
+
+		assert.Equal(t, []string{"Base", "TreeNode"}, types)
+		assert.Equal(t, []string{
+			"Base.id",
+			"TreeNode.id",
+			"TreeNode.children",
+			"TreeNode.meta",
+			"TreeNode.meta.tags",
+			"TreeNode.meta.owner",
+			"TreeNode.meta.owner.name",
+		}, fields)
+		assert.Equal(t, []string{"TreeNode.children->TreeNode"}, refs)
+		assert.Equal(t, []string{"Color"}, enums)
+		assert.Equal(t, []string{"Color.Red", "Color.Green"}, members)
+		assert.Equal(t, []string{"maxDepth"}, constants)
+		assert.Equal(t, []string{
+			"Base@entity",
+			"Base.id@id",
+			"TreeNode.id@id",
+			"Color@deprecated",
+			"Color.Red@default",
+			"maxDepth@limit",
+		}, annotations)
+	})
+
+	t.Run("returning false skips children", func(t *testing.T) {
+		var fields []string
+		Walk(schema, Visitor{
+			Type: func(typ *irtypes.TypeDef) bool {
+				return typ.Name == "TreeNode"
+			},
+			Field: func(path []string, field *irtypes.Field) bool {
+				fields = append(fields, strings.Join(path, "."))
+				return field.Name != "meta"
+			},
+		})
+
+		assert.Equal(t, []string{"TreeNode.id", "TreeNode.children", "TreeNode.meta"}, fields)
+	})
+
+	t.Run("nil schema is a no-op", func(t *testing.T) {
+		Walk(nil, Visitor{
+			Type: func(*irtypes.TypeDef) bool {
+				t.Fatal("unexpected visit")
+				return true
+			},
+		})
+	})
+}
@@ -29,6 +29,9 @@ var VDLLexer = lexer.MustSimple([]lexer.SimpleRule{
 	{Name: "Float", Pattern: `\bfloat\b`},
 	{Name: "Bool", Pattern: `\bbool\b`},
 	{Name: "Datetime", Pattern: `\bdatetime\b`},
+	{Name: "Duration", Pattern: `\bduration\b`},
+	{Name: "Uuid", Pattern: `\buuid\b`},
+	{Name: "Bytes", Pattern: `\bbytes\b`},
 	{Name: "Map", Pattern: `\bmap\b`},
 
 	// Literals
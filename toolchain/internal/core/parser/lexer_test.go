@@ -79,7 +79,7 @@ func TestLexerDelimitersAndOperators(t *testing.T) {
 }
 
 func TestLexerKeywords(t *testing.T) {
-	tokens, err := lexString("include const enum map type string int float bool datetime")
+	tokens, err := lexString("include const enum map type string int float bool datetime duration uuid bytes")
 	require.NoError(t, err)
 
 	require.Equal(t, []tokenInfo{
@@ -93,6 +93,9 @@ func TestLexerKeywords(t *testing.T) {
 		{Type: "Float", Value: "float"},
 		{Type: "Bool", Value: "bool"},
 		{Type: "Datetime", Value: "datetime"},
+		{Type: "Duration", Value: "duration"},
+		{Type: "Uuid", Value: "uuid"},
+		{Type: "Bytes", Value: "bytes"},
 		{Type: "EOF", Value: ""},
 	}, filterTokens(tokens))
 }
@@ -656,6 +656,9 @@ func TestParserTypeDecl(t *testing.T) {
 				f3 float
 				f4 bool
 				f5 datetime
+				f6 duration
+				f7 uuid
+				f8 bytes
 				optional? string
 			}
 		`
@@ -665,8 +668,8 @@ func TestParserTypeDecl(t *testing.T) {
 		require.Len(t, parsed.Declarations, 1)
 		typeDecl := parsed.Declarations[0].Type
 		require.Equal(t, "MyType", typeDecl.Name)
-		require.Len(t, typeDecl.Members(), 6)
-		require.True(t, typeDecl.Members()[5].Field.Optional)
+		require.Len(t, typeDecl.Members(), 9)
+		require.True(t, typeDecl.Members()[8].Field.Optional)
 	})
 
 	t.Run("Type with arrays and multidimensional arrays", func(t *testing.T) {
@@ -1044,6 +1047,9 @@ func TestParserKeywordsAsFieldNames(t *testing.T) {
 				float string
 				bool string
 				datetime string
+				duration string
+				uuid string
+				bytes string
 				true string
 				false string
 				rpc string
@@ -1059,7 +1065,7 @@ func TestParserKeywordsAsFieldNames(t *testing.T) {
 		parsed, err := ParserInstance.ParseString("schema.vdl", input)
 		require.NoError(t, err)
 		require.Len(t, parsed.Declarations, 1)
-		require.Len(t, parsed.Declarations[0].Type.Members(), 19)
+		require.Len(t, parsed.Declarations[0].Type.Members(), 22)
 	})
 
 	t.Run("Keywords as optional field names", func(t *testing.T) {
@@ -1080,6 +1086,42 @@ func TestParserKeywordsAsFieldNames(t *testing.T) {
 	})
 }
 
+func TestParserPrimitiveKeywordsAsNames(t *testing.T) {
+	// Primitive keywords added after these spellings were valid identifiers
+	// must keep working as annotation, const and reference names.
+	for _, keyword := range []string{"duration", "uuid", "bytes"} {
+		t.Run(keyword, func(t *testing.T) {
+			input := `
+				@` + keyword + `
+				type Token {
+					@` + keyword + `
+					id string
+				}
+
+				const ` + keyword + ` = 1
+				const alias = ` + keyword + `
+				const member = Format.` + keyword + `
+			`
+
+			parsed, err := ParserInstance.ParseString("schema.vdl", input)
+			require.NoError(t, err)
+			require.Len(t, parsed.Declarations, 4)
+
+			typeDecl := parsed.Declarations[0].Type
+			require.Equal(t, keyword, typeDecl.Annotations[0].Name)
+			require.Equal(t, keyword, typeDecl.Members()[0].Field.Annotations[0].Name)
+			require.Equal(t, keyword, parsed.Declarations[1].Const.Name)
+			require.Equal(t, keyword, parsed.Declarations[2].Const.Value.Scalar.Ref.String())
+			require.Equal(t, "Format."+keyword, parsed.Declarations[3].Const.Value.Scalar.Ref.String())
+		})
+	}
+
+	t.Run("Primitive keywords stay reserved as type names", func(t *testing.T) {
+		_, err := ParserInstance.ParseString("schema.vdl", "type duration string")
+		require.Error(t, err)
+	})
+}
+
 func TestParserAnnotations(t *testing.T) {
 	t.Run("Flags and primitive payloads", func(t *testing.T) {
 		input := `
@@ -1722,6 +1764,36 @@ func TestParserTypeAlias(t *testing.T) {
 		require.NotNil(t, typeDecl.Base.Named)
 		require.Equal(t, "datetime", *typeDecl.Base.Named)
 	})
+
+	t.Run("alias to duration", func(t *testing.T) {
+		input := `type Timeout duration`
+		parsed, err := ParserInstance.ParseString("schema.vdl", input)
+		require.NoError(t, err)
+
+		typeDecl := parsed.Declarations[0].Type
+		require.NotNil(t, typeDecl.Base.Named)
+		require.Equal(t, "duration", *typeDecl.Base.Named)
+	})
+
+	t.Run("alias to uuid", func(t *testing.T) {
+		input := `type UserId uuid`
+		parsed, err := ParserInstance.ParseString("schema.vdl", input)
+		require.NoError(t, err)
+
+		typeDecl := parsed.Declarations[0].Type
+		require.NotNil(t, typeDecl.Base.Named)
+		require.Equal(t, "uuid", *typeDecl.Base.Named)
+	})
+
+	t.Run("alias to bytes", func(t *testing.T) {
+		input := `type Blob bytes`
+		parsed, err := ParserInstance.ParseString("schema.vdl", input)
+		require.NoError(t, err)
+
+		typeDecl := parsed.Declarations[0].Type
+		require.NotNil(t, typeDecl.Base.Named)
+		require.Equal(t, "bytes", *typeDecl.Base.Named)
+	})
 }
 
 func TestParserInvalidSyntax(t *testing.T) {
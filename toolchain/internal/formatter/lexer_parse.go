@@ -115,10 +115,10 @@ func (p *tokenParser) parseTopDecl(doc *docstringNode, anns []*annotationNode) (
 		}, nil
 	case "Const":
 		p.next()
-		nameTok, err := p.expect("Ident")
-		if err != nil {
-			return nil, err
+		if !p.isIdentToken(p.peek()) {
+			return nil, p.unexpected(p.peek(), "const name")
 		}
+		nameTok := p.next()
 		if _, err := p.expect("Equals"); err != nil {
 			return nil, err
 		}
@@ -268,10 +268,10 @@ func (p *tokenParser) parseAnnotation() (*annotationNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	nameTok, err := p.expect("Ident")
-	if err != nil {
-		return nil, err
+	if !p.isIdentToken(p.peek()) {
+		return nil, p.unexpected(p.peek(), "annotation name")
 	}
+	nameTok := p.next()
 	ann := &annotationNode{
 		baseNode: baseNode{start: at.Line, end: nameTok.EndLine},
 		Name:     nameTok.Value,
@@ -358,6 +358,9 @@ func (p *tokenParser) isNameToken(tok fmtToken) bool {
 		"Float",
 		"Bool",
 		"Datetime",
+		"Duration",
+		"Uuid",
+		"Bytes",
 		"True",
 		"False":
 		return true
@@ -366,9 +369,22 @@ func (p *tokenParser) isNameToken(tok fmtToken) bool {
 	}
 }
 
+// isIdentToken reports whether tok can be used where a plain identifier is
+// expected, such as annotation and constant names. Besides identifiers this
+// accepts primitive keywords that were valid identifiers before they were
+// reserved, so names like @duration keep parsing.
+func (p *tokenParser) isIdentToken(tok fmtToken) bool {
+	switch tok.Type {
+	case "Ident", "Duration", "Uuid", "Bytes":
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *tokenParser) isTypeNameToken(tok fmtToken) bool {
 	switch tok.Type {
-	case "Ident", "String", "Int", "Float", "Bool", "Datetime":
+	case "Ident", "String", "Int", "Float", "Bool", "Datetime", "Duration", "Uuid", "Bytes":
 		return true
 	default:
 		return false
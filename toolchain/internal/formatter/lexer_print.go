@@ -144,7 +144,7 @@ func printEnumMember(output *gen.Generator, m *enumMemberNode) {
 	line := strutil.ToPascalCase(m.Name)
 	if m.Value != nil {
 		if m.Value.Str != nil {
-			line += ` = "` + strutil.EscapeQuotes(*m.Value.Str) + `"`
+			line += ` = "` + *m.Value.Str + `"`
 		} else if m.Value.Int != nil {
 			line += " = " + *m.Value.Int
 		}
@@ -17,7 +17,7 @@ func formatTypeName(name string) string {
 
 func isPrimitiveName(name string) bool {
 	switch name {
-	case "string", "int", "float", "bool", "datetime":
+	case "string", "int", "float", "bool", "datetime", "duration", "uuid", "bytes":
 		return true
 	default:
 		return false
@@ -1,6 +1,7 @@
 package lsp
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
@@ -43,6 +44,37 @@ func findIdentifierAtPosition(content string, lspPosition TextDocumentPosition)
 	return line[start:end]
 }
 
+// findQualifierAtPosition returns the enum name that qualifies the identifier
+// at the given position, as in `Color.Red` when the cursor is on `Red`. It
+// returns an empty string for unqualified identifiers and for spreads.
+func findQualifierAtPosition(content string, lspPosition TextDocumentPosition) string {
+	lines := strings.Split(content, "\n")
+	if lspPosition.Line >= len(lines) {
+		return ""
+	}
+
+	line := lines[lspPosition.Line]
+	if lspPosition.Character >= len(line) {
+		return ""
+	}
+
+	start := lspPosition.Character
+	for start > 0 && isIdentifierChar(line[start-1]) {
+		start--
+	}
+	if start < 2 || line[start-1] != '.' || !isIdentifierChar(line[start-2]) {
+		return ""
+	}
+
+	end := start - 1
+	qualifierStart := end
+	for qualifierStart > 0 && isIdentifierChar(line[qualifierStart-1]) {
+		qualifierStart--
+	}
+
+	return line[qualifierStart:end]
+}
+
 func isIdentifierChar(c byte) bool {
 	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_'
 }
@@ -336,14 +368,78 @@ func resolveSymbolDefinition(program *analysis.Program, symbolName string) *Loca
 	}
 
 	// Allow go-to-definition on enum member names.
-	for _, e := range program.Enums {
-		for _, m := range e.Members {
-			if m.Name == symbolName {
-				return symbolToLocation(m.File, m.Pos, m.EndPos)
+	if m := findEnumMember(program, "", symbolName); m != nil {
+		return symbolToLocation(m.File, m.Pos, m.EndPos)
+	}
+
+	return nil
+}
+
+// resolveQualifiedSymbolDefinition resolves `Enum.Member` references to the
+// member declaration, falling back to resolveSymbolDefinition when the
+// qualifier does not name an enum containing the member.
+func resolveQualifiedSymbolDefinition(
+	program *analysis.Program,
+	qualifier, symbolName string,
+) *Location {
+	if program != nil && qualifier != "" {
+		if m := findEnumMember(program, qualifier, symbolName); m != nil {
+			return symbolToLocation(m.File, m.Pos, m.EndPos)
+		}
+	}
+	return resolveSymbolDefinition(program, symbolName)
+}
+
+// findEnumMember looks up an enum member by name.
+//
+// When enumName is set, only that enum is searched, following its spreads so
+// members inherited through `...Base` resolve to their original declaration.
+// Otherwise every enum is searched in name order so results are deterministic.
+func findEnumMember(
+	program *analysis.Program,
+	enumName, memberName string,
+) *analysis.EnumMemberSymbol {
+	if enumName != "" {
+		return findEnumMemberInEnum(program, enumName, memberName, map[string]bool{})
+	}
+
+	names := make([]string, 0, len(program.Enums))
+	for name := range program.Enums {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		for _, m := range program.Enums[name].Members {
+			if m.Name == memberName {
+				return m
 			}
 		}
 	}
+	return nil
+}
+
+func findEnumMemberInEnum(
+	program *analysis.Program,
+	enumName, memberName string,
+	visited map[string]bool,
+) *analysis.EnumMemberSymbol {
+	e, ok := program.Enums[enumName]
+	if !ok || visited[enumName] {
+		return nil
+	}
+	visited[enumName] = true
 
+	for _, m := range e.Members {
+		if m.Name == memberName {
+			return m
+		}
+	}
+	for _, spread := range e.Spreads {
+		if m := findEnumMemberInEnum(program, spread.Name, memberName, visited); m != nil {
+			return m
+		}
+	}
 	return nil
 }
 
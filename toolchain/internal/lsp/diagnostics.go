@@ -2,7 +2,9 @@ package lsp
 
 import (
 	"context"
+	"fmt"
 	"runtime/debug"
+	"slices"
 	"time"
 
 	"github.com/varavelio/vdl/toolchain/internal/core/analysis"
@@ -111,21 +113,130 @@ func (l *LSP) analyzeAndPublishDiagnostics(ctx context.Context, filePath, uri st
 	}
 
 	// Run the analysis
-	_, diagnostics := l.analyze(ctx, filePath)
+	program, diagnostics := l.analyze(ctx, filePath)
 
 	// Check for cancellation - don't publish if cancelled
 	if ctx.Err() != nil {
 		return
 	}
 
-	// Convert analysis diagnostics to LSP diagnostics
+	// Publish diagnostics
+	l.publishDiagnostics(uri, l.diagnosticsForFile(program, diagnostics, filePath))
+}
+
+// diagnosticsForFile converts the analysis diagnostics that belong to filePath.
+//
+// Diagnostics raised inside included files carry positions of another
+// document, so instead of being shown at unrelated ranges they are summarized
+// on the include statement that (directly or transitively) brings them in.
+// Any that no include reaches are still published, at the start of the file,
+// so the editor never hides an error.
+func (l *LSP) diagnosticsForFile(
+	program *analysis.Program,
+	diagnostics []analysis.Diagnostic,
+	filePath string,
+) []Diagnostic {
 	lspDiagnostics := make([]Diagnostic, 0, len(diagnostics))
+	foreign := map[string][]analysis.Diagnostic{}
 	for _, diag := range diagnostics {
-		lspDiagnostics = append(lspDiagnostics, ConvertAnalysisDiagnosticToLSPDiagnostic(diag))
+		if diag.File == "" || diag.File == filePath {
+			lspDiagnostics = append(lspDiagnostics, ConvertAnalysisDiagnosticToLSPDiagnostic(diag))
+			continue
+		}
+		foreign[diag.File] = append(foreign[diag.File], diag)
+	}
+	if len(foreign) == 0 {
+		return lspDiagnostics
 	}
 
-	// Publish diagnostics
-	l.publishDiagnostics(uri, lspDiagnostics)
+	for _, include := range includesOf(program, filePath) {
+		includedPath := l.fs.Resolve(filePath, string(include.Path))
+
+		var count int
+		var first *analysis.Diagnostic
+		for _, path := range reachableFiles(program, includedPath) {
+			for i := range foreign[path] {
+				if first == nil {
+					first = &foreign[path][i]
+				}
+				count++
+			}
+			delete(foreign, path)
+		}
+		if first == nil {
+			continue
+		}
+
+		errorsText := "errors"
+		if count == 1 {
+			errorsText = "error"
+		}
+		lspDiagnostics = append(lspDiagnostics, Diagnostic{
+			Range: TextDocumentRange{
+				Start: convertASTPositionToLSPPosition(include.Pos),
+				End:   convertASTPositionToLSPPosition(include.EndPos),
+			},
+			Severity: DiagnosticSeverityError,
+			Code:     first.Code,
+			Source:   "vdl",
+			Message: fmt.Sprintf(
+				"included file %q has %d %s; first: %s",
+				string(include.Path), count, errorsText, first.String(),
+			),
+		})
+	}
+
+	paths := make([]string, 0, len(foreign))
+	for path := range foreign {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		for _, diag := range foreign[path] {
+			lspDiagnostics = append(lspDiagnostics, Diagnostic{
+				Severity: DiagnosticSeverityError,
+				Code:     diag.Code,
+				Source:   "vdl",
+				Message:  diag.String(),
+			})
+		}
+	}
+
+	return lspDiagnostics
+}
+
+// includesOf returns the include statements of filePath, or nil when the file
+// was not parsed.
+func includesOf(program *analysis.Program, filePath string) []*ast.Include {
+	if program == nil {
+		return nil
+	}
+	file, ok := program.Files[filePath]
+	if !ok || file.AST == nil {
+		return nil
+	}
+	return file.AST.GetIncludes()
+}
+
+// reachableFiles returns root and every file it includes transitively.
+func reachableFiles(program *analysis.Program, root string) []string {
+	var files []string
+	visited := map[string]bool{}
+	var visit func(path string)
+	visit = func(path string) {
+		if visited[path] {
+			return
+		}
+		visited[path] = true
+		files = append(files, path)
+		if file, ok := program.Files[path]; ok {
+			for _, include := range file.Includes {
+				visit(include)
+			}
+		}
+	}
+	visit(root)
+	return files
 }
 
 // analyzeAndPublishDiagnosticsImmediate runs immediate analysis without debouncing.
@@ -165,3 +165,87 @@ type B { f: A }`
 		assert.Contains(t, response, "publishDiagnostics")
 	})
 }
+
+func TestDiagnosticsForFile(t *testing.T) {
+	main := `include "./common.vdl"
+
+enum Level {
+  Warn = "warn"
+  Warning = "warn"
+}
+
+type Event {
+  ...Missing
+  level Level
+  user Usr
+}
+
+type Event {}`
+	common := `include "./nested.vdl"
+
+type Shared {
+  id Unknown
+}`
+	nested := `type Deep {
+  id Nope
+}`
+
+	l := newTestLSP(t, main, "file:///main.vdl")
+	l.fs.WriteFileCache("/common.vdl", []byte(common))
+	l.fs.WriteFileCache("/nested.vdl", []byte(nested))
+
+	program, diagnostics := l.analyze(context.Background(), "/main.vdl")
+	lspDiagnostics := l.diagnosticsForFile(program, diagnostics, "/main.vdl")
+
+	byCode := map[string][]Diagnostic{}
+	for _, diag := range lspDiagnostics {
+		byCode[diag.Code] = append(byCode[diag.Code], diag)
+	}
+
+	// Checks from the generator pipeline are reported at their own ranges.
+	require.Contains(t, byCode, analysis.CodeEnumDuplicateValue)
+	assert.Equal(t, 4, byCode[analysis.CodeEnumDuplicateValue][0].Range.Start.Line)
+	require.Contains(t, byCode, analysis.CodeSpreadTypeNotFound)
+	assert.Equal(t, 8, byCode[analysis.CodeSpreadTypeNotFound][0].Range.Start.Line)
+	require.Contains(t, byCode, analysis.CodeDuplicateType)
+
+	// Errors from included files become one diagnostic on the include statement.
+	var includeDiags []Diagnostic
+	for _, diag := range lspDiagnostics {
+		if strings.Contains(diag.Message, "included file") {
+			includeDiags = append(includeDiags, diag)
+		}
+	}
+	require.Len(t, includeDiags, 1)
+	assert.Equal(t, 0, includeDiags[0].Range.Start.Line)
+	assert.Contains(t, includeDiags[0].Message, `included file "./common.vdl" has 2 errors`)
+
+	for _, diag := range lspDiagnostics {
+		if diag.Range.Start.Line == 0 {
+			continue
+		}
+		assert.NotContains(t, diag.Message, "Unknown")
+		assert.NotContains(t, diag.Message, "Nope")
+	}
+}
+
+func TestDiagnosticsForFile_UnreachedForeignDiagnostics(t *testing.T) {
+	l := newTestLSP(t, "type Event {\n  id string\n}", "file:///main.vdl")
+	program, _ := l.analyze(context.Background(), "/main.vdl")
+
+	// A diagnostic whose file no include reaches, e.g. because the path was
+	// spelled differently, must still be published.
+	foreign := analysis.Diagnostic{
+		File:    "/other/common.vdl",
+		Pos:     ast.Position{Line: 3, Column: 5},
+		EndPos:  ast.Position{Line: 3, Column: 12},
+		Code:    analysis.CodeTypeNotDeclared,
+		Message: "type \"Unknown\" is not declared",
+	}
+	lspDiagnostics := l.diagnosticsForFile(program, []analysis.Diagnostic{foreign}, "/main.vdl")
+
+	require.Len(t, lspDiagnostics, 1)
+	assert.Equal(t, 0, lspDiagnostics[0].Range.Start.Line)
+	assert.Equal(t, analysis.CodeTypeNotDeclared, lspDiagnostics[0].Code)
+	assert.Equal(t, foreign.String(), lspDiagnostics[0].Message)
+}
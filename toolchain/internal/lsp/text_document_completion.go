@@ -60,7 +60,8 @@ type CompletionItem struct {
 	Kind  int    `json:"kind,omitempty"`
 }
 
-// handleTextDocumentCompletion provides completion after ": " in a field definition.
+// handleTextDocumentCompletion provides completion for field types, spreads,
+// and qualified enum members.
 func (l *LSP) handleTextDocumentCompletion(rawMessage []byte) (any, error) {
 	var request RequestMessageTextDocumentCompletion
 	if err := decode(rawMessage, &request); err != nil {
@@ -75,6 +76,27 @@ func (l *LSP) handleTextDocumentCompletion(rawMessage []byte) (any, error) {
 		return nil, fmt.Errorf("failed to read file from vfs: %w", err)
 	}
 
+	// Identify files to scan: current file + all dependencies
+	filesToScan := []string{filePath}
+	dependencies := l.depGraph.GetAllDependencies(filePath)
+	filesToScan = append(filesToScan, dependencies...)
+
+	// Enum member references such as "Priority.Hi" inside constants and
+	// annotation arguments complete to the members of that enum.
+	if enumName, prefix, ok := getEnumMemberCompletionContext(string(content), pos); ok {
+		var completions []CompletionItem
+		for _, member := range l.collectEnumMembers(filesToScan, enumName) {
+			if prefix == "" || strings.HasPrefix(strings.ToLower(member), strings.ToLower(prefix)) {
+				completions = append(completions, CompletionItem{Label: member, Kind: CompletionItemKindEnumMember})
+			}
+		}
+		resp := ResponseMessageTextDocumentCompletion{
+			ResponseMessage: ResponseMessage{Message: DefaultMessage, ID: request.ID},
+			Result:          completions,
+		}
+		return resp, nil
+	}
+
 	// Determine completion context
 	prefix, ctxKind, ok := getCompletionContext(string(content), pos)
 	if !ok {
@@ -100,6 +122,10 @@ func (l *LSP) handleTextDocumentCompletion(rawMessage []byte) (any, error) {
 		includePrimitives = false
 		includeEnums = false
 		includeTypes = true
+	case CompletionContextEnumSpread:
+		includePrimitives = false
+		includeEnums = true
+		includeTypes = false
 	}
 
 	itemsMap := map[string]int{}
@@ -111,11 +137,6 @@ func (l *LSP) handleTextDocumentCompletion(rawMessage []byte) (any, error) {
 		}
 	}
 
-	// Identify files to scan: current file + all dependencies
-	filesToScan := []string{filePath}
-	dependencies := l.depGraph.GetAllDependencies(filePath)
-	filesToScan = append(filesToScan, dependencies...)
-
 	for _, fPath := range filesToScan {
 		fContentBytes, err := l.fs.ReadFile(fPath)
 		if err != nil {
@@ -166,6 +187,7 @@ type CompletionContextKind int
 const (
 	CompletionContextFieldType CompletionContextKind = iota
 	CompletionContextSpread
+	CompletionContextEnumSpread
 )
 
 // getCompletionContext returns the completion prefix and kind.
@@ -208,6 +230,41 @@ func getCompletionContext(
 		return prefix, CompletionContextFieldType, true
 	}
 
+	// Case A2: Field Definition "name Type" / "name? Type" inside a type body,
+	// or the base of a type declaration "type Name Type".
+	if (isIdentifierChar(lastChar) || lastChar == '?') && idx < len(beforeCursor)-len(prefix)-1 {
+		wordEnd := idx
+		if lastChar == '?' {
+			wordEnd--
+		}
+		wordStart := wordEnd
+		for wordStart >= 0 && isIdentifierChar(beforeCursor[wordStart]) {
+			wordStart--
+		}
+		word := beforeCursor[wordStart+1 : wordEnd+1]
+		leading := strings.TrimSpace(beforeCursor[:wordStart+1])
+
+		if word != "" && leading == "" {
+			if keyword, depth := enclosingDeclaration(lines, pos.Line); keyword == "type" && depth > 0 {
+				return prefix, CompletionContextFieldType, true
+			}
+		}
+		if word != "" && leading == "type" && lastChar != '?' {
+			return prefix, CompletionContextFieldType, true
+		}
+		return "", 0, false
+	}
+
+	// Case B2: Map "map[Type"
+	if lastChar == '[' {
+		mapEndIdx := idx - 1
+		if mapEndIdx >= 2 && beforeCursor[mapEndIdx-2:mapEndIdx+1] == "map" &&
+			(mapEndIdx-3 < 0 || !isIdentifierChar(beforeCursor[mapEndIdx-3])) {
+			return prefix, CompletionContextFieldType, true
+		}
+		return "", 0, false
+	}
+
 	// Case B: Map "map<Type"
 	if lastChar == '<' {
 		// Check for "map" keyword before '<'
@@ -237,6 +294,9 @@ func getCompletionContext(
 	if lastChar == '.' {
 		// Check for "..."
 		if idx >= 2 && beforeCursor[idx-1] == '.' && beforeCursor[idx-2] == '.' {
+			if keyword, _ := enclosingDeclaration(lines, pos.Line); keyword == "enum" {
+				return prefix, CompletionContextEnumSpread, true
+			}
 			return prefix, CompletionContextSpread, true
 		}
 	}
@@ -244,10 +304,146 @@ func getCompletionContext(
 	return "", 0, false
 }
 
+// getEnumMemberCompletionContext detects a qualified enum member reference,
+// "Enum.Prefix", and returns the enum name and the typed member prefix.
+func getEnumMemberCompletionContext(
+	content string,
+	pos TextDocumentPosition,
+) (string, string, bool) {
+	lines := strings.Split(content, "\n")
+	if pos.Line >= len(lines) {
+		return "", "", false
+	}
+	line := lines[pos.Line]
+	if pos.Character > len(line) {
+		return "", "", false
+	}
+	beforeCursor := line[:pos.Character]
+
+	idx := len(beforeCursor) - 1
+	for idx >= 0 && isIdentifierChar(beforeCursor[idx]) {
+		idx--
+	}
+	prefix := beforeCursor[idx+1:]
+
+	if idx < 1 || beforeCursor[idx] != '.' || !isIdentifierChar(beforeCursor[idx-1]) {
+		return "", "", false
+	}
+
+	nameEnd := idx
+	nameStart := nameEnd - 1
+	for nameStart >= 0 && isIdentifierChar(beforeCursor[nameStart]) {
+		nameStart--
+	}
+	if nameStart >= 0 && beforeCursor[nameStart] == '.' {
+		return "", "", false
+	}
+
+	return beforeCursor[nameStart+1 : nameEnd], prefix, true
+}
+
+// enclosingDeclaration returns the keyword ("type", "enum", or "const") of the
+// top-level declaration that contains the given line, and how many braces
+// deep the start of that line is inside it.
+func enclosingDeclaration(lines []string, line int) (string, int) {
+	for i := min(line, len(lines)-1); i >= 0; i-- {
+		match := topLevelDeclRegex.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+		depth := 0
+		for _, l := range lines[i:line] {
+			depth += strings.Count(l, "{") - strings.Count(l, "}")
+		}
+		return match[1], depth
+	}
+	return "", 0
+}
+
+// collectEnumMembers returns the member names of enumName, including members
+// spread from other enums, in declaration order.
+func (l *LSP) collectEnumMembers(files []string, enumName string) []string {
+	decls := map[string]*ast.EnumDecl{}
+	fallback := map[string][]string{}
+	for _, fPath := range files {
+		fContentBytes, err := l.fs.ReadFile(fPath)
+		if err != nil {
+			continue
+		}
+		fContent := string(fContentBytes)
+
+		schema, _ := parser.ParserInstance.ParseString(fPath, fContent)
+		if schema != nil {
+			for _, e := range schema.GetEnums() {
+				if e.Name != "" {
+					if _, exists := decls[e.Name]; !exists {
+						decls[e.Name] = e
+					}
+				}
+			}
+		}
+
+		// Fallback: Regex scan for enums the parser could not reach. Spread
+		// entries are kept with their "..." prefix so they can be followed.
+		for _, match := range enumBodyRegex.FindAllStringSubmatch(fContent, -1) {
+			if _, exists := fallback[match[1]]; exists {
+				continue
+			}
+			var members []string
+			for _, memberMatch := range enumMemberLineRegex.FindAllStringSubmatch(match[2], -1) {
+				members = append(members, memberMatch[1]+memberMatch[2])
+			}
+			fallback[match[1]] = members
+		}
+	}
+
+	var members []string
+	seenMembers := map[string]bool{}
+	visited := map[string]bool{}
+	var collect func(name string)
+	collect = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+
+		decl, ok := decls[name]
+		if !ok {
+			for _, member := range fallback[name] {
+				if spreadName, isSpread := strings.CutPrefix(member, "..."); isSpread {
+					collect(spreadName)
+					continue
+				}
+				if !seenMembers[member] {
+					seenMembers[member] = true
+					members = append(members, member)
+				}
+			}
+			return
+		}
+		for _, member := range decl.Members {
+			if member.Spread != nil && member.Spread.Ref != nil {
+				collect(member.Spread.Ref.Name)
+				continue
+			}
+			if member.Name != "" && !seenMembers[member.Name] {
+				seenMembers[member.Name] = true
+				members = append(members, member.Name)
+			}
+		}
+	}
+	collect(enumName)
+
+	return members
+}
+
 // Regex for fallback extraction.
 var (
-	typeDefRegex = regexp.MustCompile(`\btype\s+([a-zA-Z_]\w*)`)
-	enumDefRegex = regexp.MustCompile(`\benum\s+([a-zA-Z_]\w*)`)
+	typeDefRegex        = regexp.MustCompile(`\btype\s+([a-zA-Z_]\w*)`)
+	enumDefRegex        = regexp.MustCompile(`\benum\s+([a-zA-Z_]\w*)`)
+	enumBodyRegex       = regexp.MustCompile(`\benum\s+([a-zA-Z_]\w*)\s*\{([^}]*)\}`)
+	enumMemberLineRegex = regexp.MustCompile(`(?m)^\s*(\.\.\.)?([a-zA-Z_]\w*)\s*(?:=.*)?$`)
+	topLevelDeclRegex   = regexp.MustCompile(`^(type|enum|const)\b`)
 )
 
 // collectCustomTypesFromContent parses the content and returns type names.
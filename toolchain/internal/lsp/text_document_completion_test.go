@@ -239,3 +239,130 @@ enum EnumC { X }
 	require.True(t, hasTypeB, "Should suggest TypeB defined below")
 	require.True(t, hasEnumC, "Should suggest EnumC defined below")
 }
+
+func TestHandleTextDocumentCompletion_FieldSyntax(t *testing.T) {
+	schema := `enum Role { Admin }
+
+type Address {}
+
+type User {
+  name 
+  role? R
+  tags map[
+  address {
+    home A
+  }
+}
+
+type Alias 
+
+const config = {
+  host 
+}`
+	uri := "file:///field.vdl"
+	l := newTestLSP(t, schema, uri)
+
+	labelsAt := func(line, character int) map[string]int {
+		t.Helper()
+		req := RequestMessageTextDocumentCompletion{
+			RequestMessage: RequestMessage{
+				Message: Message{JSONRPC: "2.0", Method: "textDocument/completion", ID: "1"},
+			},
+			Params: RequestMessageTextDocumentCompletionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     TextDocumentPosition{Line: line, Character: character},
+			},
+		}
+		b, _ := json.Marshal(req)
+		anyResp, err := l.handleTextDocumentCompletion(b)
+		require.NoError(t, err)
+		labels := map[string]int{}
+		for _, item := range anyResp.(ResponseMessageTextDocumentCompletion).Result {
+			labels[item.Label] = item.Kind
+		}
+		return labels
+	}
+
+	labels := labelsAt(5, 7) // after "name "
+	require.Equal(t, CompletionItemKindValue, labels["string"])
+	require.Equal(t, CompletionItemKindStruct, labels["Address"])
+	require.Equal(t, CompletionItemKindEnum, labels["Role"])
+
+	labels = labelsAt(6, 9) // after "role? R"
+	require.Contains(t, labels, "Role")
+	require.NotContains(t, labels, "Address")
+
+	labels = labelsAt(7, 11) // after "map["
+	require.Contains(t, labels, "int")
+	require.Contains(t, labels, "Address")
+
+	labels = labelsAt(9, 10) // after "home A" inside an inline object
+	require.Contains(t, labels, "Address")
+
+	labels = labelsAt(13, 11) // after "type Alias "
+	require.Contains(t, labels, "datetime")
+
+	require.Empty(t, labelsAt(16, 7), "object literal keys in constants are not field types")
+	require.Empty(t, labelsAt(5, 4), "field names are not completed")
+}
+
+func TestHandleTextDocumentCompletion_EnumMembers(t *testing.T) {
+	schemaBase := `enum BaseLevel {
+  Debug
+  Info = "info"
+}`
+	schemaMain := `include "base.vdl"
+
+enum Level {
+  ...
+  ...BaseLevel
+  Warn
+}
+
+@level(Level.)
+type Event {}
+
+const defaultLevel = Level.W
+`
+	uriBase := "file:///base.vdl"
+	uriMain := "file:///levels.vdl"
+	l := newTestLSP(t, schemaBase, uriBase)
+	l.fs.WriteFileCache(UriToPath(uriMain), []byte(schemaMain))
+	l.depGraph.UpdateDependencies(UriToPath(uriMain), []string{UriToPath(uriBase)})
+
+	completeAt := func(line, character int) []CompletionItem {
+		t.Helper()
+		req := RequestMessageTextDocumentCompletion{
+			RequestMessage: RequestMessage{
+				Message: Message{JSONRPC: "2.0", Method: "textDocument/completion", ID: "1"},
+			},
+			Params: RequestMessageTextDocumentCompletionParams{
+				TextDocument: TextDocumentIdentifier{URI: uriMain},
+				Position:     TextDocumentPosition{Line: line, Character: character},
+			},
+		}
+		b, _ := json.Marshal(req)
+		anyResp, err := l.handleTextDocumentCompletion(b)
+		require.NoError(t, err)
+		return anyResp.(ResponseMessageTextDocumentCompletion).Result
+	}
+
+	require.Equal(t, []CompletionItem{
+		{Label: "Debug", Kind: CompletionItemKindEnumMember},
+		{Label: "Info", Kind: CompletionItemKindEnumMember},
+		{Label: "Warn", Kind: CompletionItemKindEnumMember},
+	}, completeAt(8, 13))
+
+	require.Equal(t, []CompletionItem{
+		{Label: "Warn", Kind: CompletionItemKindEnumMember},
+	}, completeAt(11, 28))
+
+	// Spreads inside an enum body suggest enums rather than types.
+	items := completeAt(3, 5)
+	labels := make([]string, 0, len(items))
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	require.Contains(t, labels, "BaseLevel")
+	require.NotContains(t, labels, "Event")
+}
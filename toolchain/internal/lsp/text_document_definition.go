@@ -80,8 +80,9 @@ func (l *LSP) handleTextDocumentDefinition(rawMessage []byte) (any, error) {
 		}, nil
 	}
 
-	// Find the definition
-	location := resolveSymbolDefinition(program, identifier)
+	// Find the definition, honoring `Enum.Member` qualification
+	qualifier := findQualifierAtPosition(string(content), position)
+	location := resolveQualifiedSymbolDefinition(program, qualifier, identifier)
 
 	var result []Location
 	if location != nil {
@@ -112,3 +112,122 @@ type Wrapper {
 	assert.Contains(t, defResponse.Result[0].URI, filePath)
 	assert.Equal(t, 0, defResponse.Result[0].Range.Start.Line) // FooType is on line 0 (0-based)
 }
+
+func TestFindQualifierAtPosition(t *testing.T) {
+	content := `const a = Color.Red
+type B {
+  ...Base
+}`
+
+	tests := []struct {
+		name     string
+		position TextDocumentPosition
+		want     string
+	}{
+		{
+			name:     "Find qualifier of enum member",
+			position: TextDocumentPosition{Line: 0, Character: 17},
+			want:     "Color",
+		},
+		{
+			name:     "Qualifier itself is unqualified",
+			position: TextDocumentPosition{Line: 0, Character: 11},
+			want:     "",
+		},
+		{
+			name:     "Spread is unqualified",
+			position: TextDocumentPosition{Line: 2, Character: 6},
+			want:     "",
+		},
+		{
+			name:     "Position out of range",
+			position: TextDocumentPosition{Line: 9, Character: 0},
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findQualifierAtPosition(content, tt.position)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandleTextDocumentDefinition_References(t *testing.T) {
+	common := `enum BaseLevel {
+  Info
+}`
+	schema := `include "./common.vdl"
+
+enum Color {
+  Red
+  Info
+}
+
+enum Level {
+  ...BaseLevel
+  Warn
+}
+
+type Timestamps {
+  createdAt datetime
+}
+
+type Event {
+  ...Timestamps
+  level Level
+}
+
+const defaultColor = Color.Info
+const defaultLevel = Level.Info`
+
+	uri := "file:///main.vdl"
+	l := newTestLSP(t, schema, uri)
+	l.fs.WriteFileCache("/common.vdl", []byte(common))
+
+	definitionAt := func(line, character int) []Location {
+		t.Helper()
+		request := RequestMessageTextDocumentDefinition{
+			RequestMessage: RequestMessage{
+				Message: Message{JSONRPC: "2.0", Method: "textDocument/definition", ID: "1"},
+			},
+			Params: RequestMessageTextDocumentDefinitionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     TextDocumentPosition{Line: line, Character: character},
+			},
+		}
+		requestBytes, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, err := l.handleTextDocumentDefinition(requestBytes)
+		require.NoError(t, err)
+		return response.(ResponseMessageTextDocumentDefinition).Result
+	}
+
+	t.Run("spread resolves to the spread source", func(t *testing.T) {
+		result := definitionAt(17, 6)
+		require.Len(t, result, 1)
+		assert.Equal(t, uri, result[0].URI)
+		assert.Equal(t, 12, result[0].Range.Start.Line)
+	})
+
+	t.Run("qualified member resolves within its enum", func(t *testing.T) {
+		result := definitionAt(21, 28)
+		require.Len(t, result, 1)
+		assert.Equal(t, uri, result[0].URI)
+		assert.Equal(t, 4, result[0].Range.Start.Line)
+	})
+
+	t.Run("qualified member follows enum spreads across files", func(t *testing.T) {
+		result := definitionAt(22, 28)
+		require.Len(t, result, 1)
+		assert.Equal(t, "file:///common.vdl", result[0].URI)
+		assert.Equal(t, 1, result[0].Range.Start.Line)
+	})
+
+	t.Run("qualifier resolves to the enum", func(t *testing.T) {
+		result := definitionAt(22, 22)
+		require.Len(t, result, 1)
+		assert.Equal(t, 7, result[0].Range.Start.Line)
+	})
+}
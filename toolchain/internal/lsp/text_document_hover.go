@@ -76,8 +76,9 @@ func (l *LSP) handleTextDocumentHover(rawMessage []byte) (any, error) {
 		}, nil
 	}
 
-	// Find the hover information
-	hoverResult := findHoverInfo(l.fs, identifier, program)
+	// Find the hover information, resolving `Enum.Member` through the enum
+	qualifier := findQualifierAtPosition(string(content), position)
+	hoverResult := findHoverInfo(l.fs, qualifier, identifier, program)
 
 	response := ResponseMessageTextDocumentHover{
 		ResponseMessage: ResponseMessage{
@@ -90,12 +91,20 @@ func (l *LSP) handleTextDocumentHover(rawMessage []byte) (any, error) {
 	return response, nil
 }
 
-// findHoverInfo finds hover information for a symbol in the program.
+// findHoverInfo finds hover information for a symbol in the program. A
+// non-empty qualifier names the enum the identifier is a member of.
 func findHoverInfo(
 	fs interface{ ReadFile(string) ([]byte, error) },
-	identifier string,
+	qualifier, identifier string,
 	program *analysis.Program,
 ) *HoverResult {
+	// Check if the identifier is a member of the qualifying enum
+	if qualifier != "" {
+		if m := findEnumMember(program, qualifier, identifier); m != nil {
+			return enumMemberHover(fs, m)
+		}
+	}
+
 	// Check if the identifier is a type
 	if t, ok := program.Types[identifier]; ok {
 		sourceCode, err := extractSourceCode(fs, t.File, t.Pos.Line, t.EndPos.Line)
@@ -138,27 +147,29 @@ func findHoverInfo(
 		}
 	}
 
-	for _, e := range program.Enums {
-		for _, m := range e.Members {
-			if m.Name != identifier {
-				continue
-			}
+	if m := findEnumMember(program, "", identifier); m != nil {
+		return enumMemberHover(fs, m)
+	}
 
-			sourceCode, err := extractSourceCode(fs, m.File, m.Pos.Line, m.EndPos.Line)
-			if err != nil {
-				return nil
-			}
+	return nil
+}
 
-			return &HoverResult{
-				Contents: MarkupContent{
-					Kind:  "markdown",
-					Value: fmt.Sprintf("```vdl\n%s\n```", sourceCode),
-				},
-			}
-		}
+// enumMemberHover renders the declaration of an enum member.
+func enumMemberHover(
+	fs interface{ ReadFile(string) ([]byte, error) },
+	m *analysis.EnumMemberSymbol,
+) *HoverResult {
+	sourceCode, err := extractSourceCode(fs, m.File, m.Pos.Line, m.EndPos.Line)
+	if err != nil {
+		return nil
 	}
 
-	return nil
+	return &HoverResult{
+		Contents: MarkupContent{
+			Kind:  "markdown",
+			Value: fmt.Sprintf("```vdl\n%s\n```", sourceCode),
+		},
+	}
 }
 
 // extractSourceCode extracts a range of lines from a file.
@@ -127,3 +127,48 @@ type Wrapper {
 	assert.Contains(t, hoverResponse.Result.Contents.Value, "```vdl")
 	assert.Contains(t, hoverResponse.Result.Contents.Value, "type FooType")
 }
+
+func TestHandleTextDocumentHover_QualifiedEnumMember(t *testing.T) {
+	schema := `enum BaseLevel {
+  Info = "base-info"
+}
+
+enum Color {
+  Info = "color-info"
+}
+
+const defaultColor = Color.Info`
+
+	uri := "file:///main.vdl"
+	l := newTestLSP(t, schema, uri)
+
+	hoverAt := func(line, character int) *HoverResult {
+		t.Helper()
+		request := RequestMessageTextDocumentHover{
+			RequestMessage: RequestMessage{
+				Message: Message{JSONRPC: "2.0", Method: "textDocument/hover", ID: "1"},
+			},
+			Params: RequestMessageTextDocumentHoverParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     TextDocumentPosition{Line: line, Character: character},
+			},
+		}
+		requestBytes, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, err := l.handleTextDocumentHover(requestBytes)
+		require.NoError(t, err)
+		return response.(ResponseMessageTextDocumentHover).Result
+	}
+
+	t.Run("qualified member resolves within its enum", func(t *testing.T) {
+		result := hoverAt(8, 28)
+		require.NotNil(t, result)
+		assert.Contains(t, result.Contents.Value, `Info = "color-info"`)
+	})
+
+	t.Run("qualifier resolves to the enum", func(t *testing.T) {
+		result := hoverAt(8, 22)
+		require.NotNil(t, result)
+		assert.Contains(t, result.Contents.Value, "enum Color {")
+	})
+}
@@ -5,9 +5,10 @@ import (
 )
 
 // NormalizeIndent removes the common leading indentation from every line in text.
-// It computes the minimum indentation across all non-empty lines (counting tabs as 4 spaces)
-// and strips that amount of visual indentation from each line.
-// It preserves relative indentation and vertical whitespace.
+// The common indentation is the longest run of leading spaces and tabs shared
+// verbatim by all non-empty lines, so lines that indent with different mixes
+// of tabs and spaces only lose what they actually have in common and content
+// is never removed. It preserves relative indentation and vertical whitespace.
 func NormalizeIndent(text string) string {
 	if text == "" {
 		return ""
@@ -15,37 +16,26 @@ func NormalizeIndent(text string) string {
 
 	lines := strings.Split(text, "\n")
 
-	// Find minimum indentation (ignoring empty lines)
-	minIndent := -1
+	// Find the common leading whitespace (ignoring empty lines)
+	common := ""
+	found := false
 	for _, line := range lines {
 		if strings.TrimSpace(line) == "" {
 			continue
 		}
 
-		indent := 0
-	countLoop:
-		for _, ch := range line {
-			switch ch {
-			case ' ':
-				indent++
-			case '\t':
-				indent += 4 // Treat tab as 4 spaces
-			default:
-				break countLoop
-			}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !found {
+			common = indent
+			found = true
+			continue
 		}
-
-		if minIndent == -1 || indent < minIndent {
-			minIndent = indent
+		common = commonPrefix(common, indent)
+		if common == "" {
+			break
 		}
 	}
 
-	// If no indentation found, default to 0 so we still process lines
-	// (e.g. to clean up whitespace-only lines)
-	if minIndent == -1 {
-		minIndent = 0
-	}
-
 	// Remove common indentation from each line
 	result := make([]string, 0, len(lines))
 	for _, line := range lines {
@@ -55,28 +45,19 @@ func NormalizeIndent(text string) string {
 			result = append(result, "")
 			continue
 		}
-
-		// Count leading whitespace and remove up to minIndent
-		removed := 0
-		idx := 0
-	stripLoop:
-		for i, ch := range line {
-			if removed >= minIndent {
-				break
-			}
-			switch ch {
-			case ' ':
-				removed++
-				idx = i + 1
-			case '\t':
-				removed += 4
-				idx = i + 1
-			default:
-				break stripLoop
-			}
-		}
-		result = append(result, line[idx:])
+		result = append(result, strings.TrimPrefix(line, common))
 	}
 
 	return strings.Join(result, "\n")
 }
+
+// commonPrefix returns the longest prefix shared by a and b.
+func commonPrefix(a, b string) string {
+	n := min(len(a), len(b))
+	for i := range n {
+		if a[i] != b[i] {
+			return a[:i]
+		}
+	}
+	return a[:n]
+}
@@ -93,6 +93,21 @@ first line
 third line
 `,
 		},
+		{
+			name:     "Tab then space lines keep content",
+			input:    "\t first\n\t  second\n\tthird",
+			expected: " first\n  second\nthird",
+		},
+		{
+			name:     "Space then tab lines keep content",
+			input:    "  \tfirst\n  \t\tsecond\n  third",
+			expected: "\tfirst\n\t\tsecond\nthird",
+		},
+		{
+			name:     "Tab and spaces with no shared prefix are left intact",
+			input:    "\tfirst\n    second",
+			expected: "\tfirst\n    second",
+		},
 	}
 
 	for _, tc := range testCases {